@@ -0,0 +1,271 @@
+package timex
+
+import (
+	"math"
+	"time"
+)
+
+// Astronomical algorithms from Jean Meeus, Astronomical Algorithms, 2nd edition.
+
+const (
+	julianDayUnixEpoch = 2440587.5
+	julianDayJ2000     = 2451545.0
+	degToRad           = math.Pi / 180
+	arcsecToDeg        = 1.0 / 3600
+)
+
+// julianDay converts t into Julian Day in UT
+func julianDay(t time.Time) float64 {
+	return julianDayUnixEpoch + float64(t.UnixNano())/float64(Day)
+}
+
+// timeOfJulianDay converts Julian Day in UT into time
+func timeOfJulianDay(jd float64) time.Time {
+	days := jd - julianDayUnixEpoch
+	sec := math.Floor(days * 86400)
+	nsec := (days*86400 - sec) * 1e9
+	return time.Unix(int64(sec), int64(nsec)).UTC()
+}
+
+// deltaT returns TT - UT in seconds by polynomial expressions of Espenak and Meeus
+func deltaT(year float64) float64 {
+	switch {
+	case year < 1900:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u
+	case year < 1920:
+		t := year - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case year < 1941:
+		t := year - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case year < 1961:
+		t := year - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case year < 1986:
+		t := year - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case year < 2005:
+		t := year - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case year < 2050:
+		t := year - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case year < 2150:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-year)
+	default:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// dynamicalToUniversal converts Julian Ephemeris Day into Julian Day
+func dynamicalToUniversal(jde float64) float64 {
+	year := 2000 + (jde-julianDayJ2000)/365.25
+	return jde - deltaT(year)/86400
+}
+
+func universalToDynamical(jd float64) float64 {
+	year := 2000 + (jd-julianDayJ2000)/365.25
+	return jd + deltaT(year)/86400
+}
+
+func normalizeDegrees(d float64) float64 {
+	d = math.Mod(d, 360)
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
+
+type vsopTerm [3]float64
+
+// Earth heliocentric longitude terms of VSOP87, truncated as in Meeus appendix III
+var earthLongitudeTerms = [][]vsopTerm{
+	{
+		{175347046, 0, 0},
+		{3341656, 4.6692568, 6283.07585},
+		{34894, 4.6261, 12566.1517},
+		{3497, 2.7441, 5753.3849},
+		{3418, 2.8289, 3.5231},
+		{3136, 3.6277, 77713.7715},
+		{2676, 4.4181, 7860.4194},
+		{2343, 6.1352, 3930.2097},
+		{1324, 0.7425, 11506.7698},
+		{1273, 2.0371, 529.691},
+		{1199, 1.1096, 1577.3435},
+		{990, 5.233, 5884.927},
+		{902, 2.045, 26.298},
+		{857, 3.508, 398.149},
+		{780, 1.179, 5223.694},
+		{753, 2.533, 5507.553},
+		{505, 4.583, 18849.228},
+		{492, 4.205, 775.523},
+		{357, 2.920, 0.067},
+		{317, 5.849, 11790.629},
+		{284, 1.899, 796.298},
+		{271, 0.315, 10977.079},
+		{243, 0.345, 5486.778},
+		{206, 4.806, 2544.314},
+		{205, 1.869, 5573.143},
+		{202, 2.458, 6069.777},
+		{156, 0.833, 213.299},
+		{132, 3.411, 2942.463},
+		{126, 1.083, 20.775},
+		{115, 0.645, 0.980},
+		{103, 0.636, 4694.003},
+		{102, 0.976, 15720.839},
+		{102, 4.267, 7.114},
+		{99, 6.21, 2146.17},
+		{98, 0.68, 155.42},
+		{86, 5.98, 161000.69},
+		{85, 1.30, 6275.96},
+		{85, 3.67, 71430.70},
+		{80, 1.81, 17260.15},
+		{79, 3.04, 12036.46},
+		{75, 1.76, 5088.63},
+		{74, 3.50, 3154.69},
+		{74, 4.68, 801.82},
+		{70, 0.83, 9437.76},
+		{62, 3.98, 8827.39},
+		{61, 1.82, 7084.90},
+		{57, 2.78, 6286.60},
+		{56, 4.39, 14143.50},
+		{56, 3.47, 6279.55},
+		{52, 0.19, 12139.55},
+		{52, 1.33, 1748.02},
+		{51, 0.28, 5856.48},
+		{49, 0.49, 1194.45},
+		{41, 5.37, 8429.24},
+		{41, 2.40, 19651.05},
+		{39, 6.17, 10447.39},
+		{37, 6.04, 10213.29},
+		{37, 2.57, 1059.38},
+		{36, 1.71, 2352.87},
+		{36, 1.78, 6812.77},
+		{33, 0.59, 17789.85},
+		{30, 0.44, 83996.85},
+		{30, 2.74, 1349.87},
+		{25, 3.16, 4690.48},
+	},
+	{
+		{628331966747, 0, 0},
+		{206059, 2.678235, 6283.07585},
+		{4303, 2.6351, 12566.1517},
+		{425, 1.590, 3.523},
+		{119, 5.796, 26.298},
+		{109, 2.966, 1577.344},
+		{93, 2.59, 18849.23},
+		{72, 1.14, 529.69},
+		{68, 1.87, 398.15},
+		{67, 4.41, 5507.55},
+		{59, 2.89, 5223.69},
+		{56, 2.17, 155.42},
+		{45, 0.40, 796.30},
+		{36, 0.47, 775.52},
+		{29, 2.65, 7.11},
+		{21, 5.34, 0.98},
+		{19, 1.85, 5486.78},
+		{19, 4.97, 213.30},
+		{17, 2.99, 6275.96},
+		{16, 0.03, 2544.31},
+		{16, 1.43, 2146.17},
+		{15, 1.21, 10977.08},
+		{12, 2.83, 1748.02},
+		{12, 3.26, 5088.63},
+		{12, 5.27, 1194.45},
+		{12, 2.08, 4694.00},
+		{11, 0.77, 553.57},
+		{10, 1.30, 6286.60},
+		{10, 4.24, 1349.87},
+		{9, 2.70, 242.73},
+		{9, 5.64, 951.72},
+		{8, 5.30, 2352.87},
+		{6, 2.65, 9437.76},
+		{6, 4.67, 4690.48},
+	},
+	{
+		{52919, 0, 0},
+		{8720, 1.0721, 6283.0758},
+		{309, 0.867, 12566.152},
+		{27, 0.05, 3.52},
+		{16, 5.19, 26.30},
+		{16, 3.68, 155.42},
+		{10, 0.76, 18849.23},
+		{9, 2.06, 77713.77},
+		{7, 0.83, 775.52},
+		{5, 4.66, 1577.34},
+		{4, 1.03, 7.11},
+		{4, 3.44, 5573.14},
+		{3, 5.14, 796.30},
+		{3, 6.05, 5507.55},
+		{3, 1.19, 242.73},
+		{3, 6.12, 529.69},
+		{3, 0.31, 398.15},
+		{3, 2.28, 553.57},
+		{2, 4.38, 5223.69},
+		{2, 3.75, 0.98},
+	},
+	{
+		{289, 5.844, 6283.076},
+		{35, 0, 0},
+		{17, 5.49, 12566.15},
+		{3, 5.20, 155.42},
+		{1, 4.72, 3.52},
+		{1, 5.30, 18849.23},
+		{1, 5.97, 242.73},
+	},
+	{
+		{114, 3.142, 0},
+		{8, 4.13, 6283.08},
+		{1, 3.84, 12566.15},
+	},
+	{
+		{1, 3.14, 0},
+	},
+}
+
+// sunApparentLongitude returns apparent geocentric longitude of the sun in degrees at Julian Ephemeris Day
+func sunApparentLongitude(jde float64) float64 {
+	tau := (jde - julianDayJ2000) / 365250
+	var l float64
+	for i := len(earthLongitudeTerms) - 1; i >= 0; i-- {
+		var s float64
+		for _, v := range earthLongitudeTerms[i] {
+			s += v[0] * math.Cos(v[1]+v[2]*tau)
+		}
+		l = l*tau + s
+	}
+	// geocentric longitude is opposite to earth's heliocentric longitude
+	lon := l/1e8/degToRad + 180
+
+	t := tau * 10
+	// conversion to FK5
+	lon -= 0.09033 * arcsecToDeg
+	// nutation in longitude
+	omega := (125.04452 - 1934.136261*t) * degToRad
+	ls := (280.4665 + 36000.7698*t) * degToRad
+	lm := (218.3165 + 481267.8813*t) * degToRad
+	lon += (-17.20*math.Sin(omega) - 1.32*math.Sin(2*ls) - 0.23*math.Sin(2*lm) + 0.21*math.Sin(2*omega)) * arcsecToDeg
+	// aberration
+	m := (357.52911 + 35999.05029*t) * degToRad
+	r := 1.000140 - 0.016708*math.Cos(m) - 0.000139*math.Cos(2*m)
+	lon -= 20.4898 / r * arcsecToDeg
+	return normalizeDegrees(lon)
+}
+
+// sunLongitudeTime returns the instant when the sun's apparent longitude reaches lon degrees, searching near Julian Ephemeris Day jde
+func sunLongitudeTime(lon, jde float64) time.Time {
+	for i := 0; i < 50; i++ {
+		diff := lon - sunApparentLongitude(jde)
+		diff = normalizeDegrees(diff+180) - 180
+		// the sun moves about 360/365.2422 degrees per day
+		delta := diff * 365.2422 / 360
+		jde += delta
+		if math.Abs(delta) < 1e-7 {
+			break
+		}
+	}
+	return timeOfJulianDay(dynamicalToUniversal(jde))
+}
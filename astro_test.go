@@ -0,0 +1,96 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertNear(t *testing.T, expected, actual time.Time, msgAndArgs ...interface{}) {
+	d := actual.Sub(expected)
+	if d < 0 {
+		d = -d
+	}
+	assert.True(t, d <= 2*time.Minute, append([]interface{}{"expected %v, actual %v", expected, actual}, msgAndArgs...)...)
+}
+
+func TestDate_SunTimes(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	p := timex.NewPlace(51.5074, -0.1278, london)
+	s := timex.NewDate(2026, 6, 21).SunTimes(p)
+	assertNear(t, time.Date(2026, 6, 21, 4, 43, 0, 0, london), s.Sunrise)
+	assertNear(t, time.Date(2026, 6, 21, 21, 21, 0, 0, london), s.Sunset)
+	assertNear(t, time.Date(2026, 6, 21, 13, 2, 0, 0, london), s.SolarNoon)
+	assert.True(t, s.CivilDawn.Before(s.Sunrise))
+	assert.True(t, s.CivilDusk.After(s.Sunset))
+	// no astronomical night in London around summer solstice
+	assert.True(t, s.AstronomicalDawn.IsZero())
+	assert.False(t, s.NauticalDawn.IsZero())
+	assert.Equal(t, london, s.Sunrise.Location())
+
+	r := s.Daylight()
+	require.NotNil(t, r)
+	assert.True(t, r.Duration() > 16*time.Hour+30*time.Minute)
+
+	shanghai := time.FixedZone("CST", 8*3600)
+	s = timex.NewDate(2026, 12, 22).SunTimes(timex.NewPlace(31.2304, 121.4737, shanghai))
+	assertNear(t, time.Date(2026, 12, 22, 6, 49, 0, 0, shanghai), s.Sunrise)
+	assertNear(t, time.Date(2026, 12, 22, 16, 56, 0, 0, shanghai), s.Sunset)
+}
+
+func TestDate_SunTimes_FarZone(t *testing.T) {
+	// Kiritimati is at 157°W in UTC+14, its solar noon is about 12:30 of the previous UTC day
+	kiritimati := time.FixedZone("LINT", 14*3600)
+	s := timex.NewDate(2026, 6, 21).SunTimes(timex.NewPlace(1.8721, -157.4278, kiritimati))
+	assertNear(t, time.Date(2026, 6, 21, 6, 24, 0, 0, kiritimati), s.Sunrise)
+	assertNear(t, time.Date(2026, 6, 21, 12, 31, 0, 0, kiritimati), s.SolarNoon)
+	assertNear(t, time.Date(2026, 6, 21, 18, 38, 0, 0, kiritimati), s.Sunset)
+
+	// UTC-12 at 175°E
+	far := time.FixedZone("UTC-12", -12*3600)
+	s = timex.NewDate(2026, 6, 21).SunTimes(timex.NewPlace(0, 175, far))
+	assert.Equal(t, 21, s.Sunrise.Day())
+	assert.Equal(t, 21, s.Sunset.Day())
+}
+
+func TestDate_SunTimes_Polar(t *testing.T) {
+	tromso := timex.NewPlace(69.6492, 18.9553, time.FixedZone("CET", 3600))
+	s := timex.NewDate(2026, 6, 21).SunTimes(tromso)
+	assert.True(t, s.IsPolarDay)
+	assert.True(t, s.Sunrise.IsZero())
+	assert.Equal(t, 24*time.Hour, s.DaylightDuration())
+
+	s = timex.NewDate(2026, 12, 21).SunTimes(tromso)
+	assert.True(t, s.IsPolarNight)
+	assert.Nil(t, s.Daylight())
+	assert.False(t, s.CivilDawn.IsZero())
+}
+
+func TestMoon(t *testing.T) {
+	// total solar eclipse
+	l := timex.NewRange(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)).NewMoons()
+	require.Equal(t, 1, len(l))
+	assertNear(t, time.Date(2024, 4, 8, 18, 21, 0, 0, time.UTC), l[0])
+	// partial lunar eclipse
+	l = timex.NewRange(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)).FullMoons()
+	require.Equal(t, 1, len(l))
+	assertNear(t, time.Date(2024, 9, 18, 2, 34, 0, 0, time.UTC), l[0])
+
+	m := timex.NewMonth(2026, 2)
+	require.Equal(t, 1, len(m.NewMoons()))
+	// Chinese New Year begins on the day of new moon in China Standard Time
+	assert.Equal(t, 17, m.NewMoons()[0].In(time.FixedZone("CST", 8*3600)).Day())
+
+	info := timex.MoonInfoAt(l[0])
+	assert.Equal(t, timex.FullMoon, info.Phase)
+	assert.InDelta(t, 1, info.Illumination, 0.01)
+	info = timex.NewDate(2024, 4, 8).MoonInfo(time.UTC)
+	assert.Equal(t, timex.NewMoon, info.Phase)
+	assert.InDelta(t, 0, info.Illumination, 0.01)
+	assert.Equal(t, timex.FirstQuarter, timex.NewDate(2024, 4, 15).MoonInfo(time.UTC).Phase)
+	assert.Equal(t, "Last Quarter", timex.LastQuarter.String())
+}
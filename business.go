@@ -0,0 +1,181 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// Weekend is a set of weekdays, bit i is set if weekday i is in the set
+type Weekend int
+
+const (
+	SaturdaySunday Weekend = 1<<time.Saturday | 1<<time.Sunday
+	FridaySaturday Weekend = 1<<time.Friday | 1<<time.Saturday
+	SundayOnly     Weekend = 1 << time.Sunday
+)
+
+func (w Weekend) Contains(weekday int) bool {
+	return w&(1<<uint(weekday%7)) != 0
+}
+
+// BusinessCalendar defines business days with weekend and optional holidays.
+// Make-up workdays are business days if Holidays implements MakeupWorkdayProvider.
+type BusinessCalendar struct {
+	Weekend  Weekend
+	Holidays HolidayProvider
+}
+
+var defaultBusinessCalendar = &BusinessCalendar{Weekend: SaturdaySunday}
+
+func NewBusinessCalendar(weekend Weekend, holidays HolidayProvider) *BusinessCalendar {
+	return &BusinessCalendar{
+		Weekend:  weekend,
+		Holidays: holidays,
+	}
+}
+
+func (c *BusinessCalendar) IsBusinessDay(d *Date) bool {
+	if c == nil {
+		c = defaultBusinessCalendar
+	}
+	if c.Holidays != nil {
+		if w, ok := c.Holidays.(MakeupWorkdayProvider); ok && w.IsMakeupWorkday(d) {
+			return true
+		}
+		if c.Holidays.IsHoliday(d) {
+			return false
+		}
+	}
+	return !c.Weekend.Contains(d.weekday)
+}
+
+// RollConvention adjusts a date which falls on a non-business day
+type RollConvention int
+
+const (
+	// Following rolls to the next business day
+	Following RollConvention = iota
+	// ModifiedFollowing rolls to the next business day unless it's in next month, in which case it rolls to the previous business day
+	ModifiedFollowing
+	// Preceding rolls to the previous business day
+	Preceding
+	// ModifiedPreceding rolls to the previous business day unless it's in previous month, in which case it rolls to the next business day
+	ModifiedPreceding
+)
+
+func (r RollConvention) String() string {
+	switch r {
+	case Following:
+		return "following"
+	case ModifiedFollowing:
+		return "modified following"
+	case Preceding:
+		return "preceding"
+	case ModifiedPreceding:
+		return "modified preceding"
+	default:
+		return fmt.Sprint(int(r))
+	}
+}
+
+// IsBusinessDay checks d with calendar c. Nil c means Saturday and Sunday are weekend without holidays.
+func (d *Date) IsBusinessDay(c *BusinessCalendar) bool {
+	return c.IsBusinessDay(d)
+}
+
+// NextBusinessDay returns the first business day after d
+func (d *Date) NextBusinessDay(c *BusinessCalendar) *Date {
+	return d.Add(0, 0, 1).Roll(Following, c)
+}
+
+// PrevBusinessDay returns the last business day before d
+func (d *Date) PrevBusinessDay(c *BusinessCalendar) *Date {
+	return d.Add(0, 0, -1).Roll(Preceding, c)
+}
+
+// Roll returns d if it's a business day, otherwise adjusts it by convention
+func (d *Date) Roll(conv RollConvention, c *BusinessCalendar) *Date {
+	if c.IsBusinessDay(d) {
+		return d
+	}
+	switch conv {
+	case Following, ModifiedFollowing:
+		r := d.seekBusinessDay(1, c)
+		if conv == ModifiedFollowing && r.month != d.month {
+			return d.seekBusinessDay(-1, c)
+		}
+		return r
+	case Preceding, ModifiedPreceding:
+		r := d.seekBusinessDay(-1, c)
+		if conv == ModifiedPreceding && r.month != d.month {
+			return d.seekBusinessDay(1, c)
+		}
+		return r
+	default:
+		panic(fmt.Sprintf("timex: invalid roll convention %v", conv))
+	}
+}
+
+func (d *Date) seekBusinessDay(step int, c *BusinessCalendar) *Date {
+	r := d
+	// a year without business days must be a wrong calendar
+	for i := 0; i < 366; i++ {
+		if c.IsBusinessDay(r) {
+			return r
+		}
+		r = r.Add(0, 0, step)
+	}
+	panic("timex: no business day in a year")
+}
+
+// AddBusinessDays returns the date n business days after d, or before d if n is negative
+func (d *Date) AddBusinessDays(n int, c *BusinessCalendar) *Date {
+	step := 1
+	if n < 0 {
+		step = -1
+		n = -n
+	}
+	r := d
+	for ; n > 0; n-- {
+		r = r.Add(0, 0, step).seekBusinessDay(step, c)
+	}
+	return r
+}
+
+// BusinessDaysBetween returns number of business days in [begin, end). It's negative if end is before begin.
+func BusinessDaysBetween(begin, end *Date, c *BusinessCalendar) int {
+	sign := 1
+	if daysBetween(begin, end) < 0 {
+		begin, end = end, begin
+		sign = -1
+	}
+	n := 0
+	for d := begin; daysBetween(d, end) > 0; d = d.Add(0, 0, 1) {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return sign * n
+}
+
+// NumOfBusinessDays returns number of business days which the range covers
+func (r *Range) NumOfBusinessDays(c *BusinessCalendar) int {
+	n := 0
+	for _, d := range r.Dates() {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// BusinessDays returns business days which the range covers
+func (r *Range) BusinessDays(c *BusinessCalendar) []*Date {
+	var l []*Date
+	for _, d := range r.Dates() {
+		if c.IsBusinessDay(d) {
+			l = append(l, d)
+		}
+	}
+	return l
+}
@@ -0,0 +1,151 @@
+package timex
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DayInterval is [Begin, End) in a day, both are wall clock offsets from midnight, e.g. 9*time.Hour
+type DayInterval struct {
+	Begin time.Duration `json:"begin"`
+	End   time.Duration `json:"end"`
+}
+
+// BusinessHours is a weekly schedule of working intervals in a time zone, excluding holidays.
+// Make-up workdays follow Monday's intervals.
+type BusinessHours struct {
+	location  *time.Location
+	intervals [7][]*DayInterval
+	holidays  HolidayProvider
+}
+
+// NewBusinessHours creates an empty schedule, nil loc means time.Local
+func NewBusinessHours(loc *time.Location, holidays HolidayProvider) *BusinessHours {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &BusinessHours{
+		location: loc,
+		holidays: holidays,
+	}
+}
+
+// NewWorkdayBusinessHours creates a schedule of [begin, end) on Monday to Friday, e.g. 9*time.Hour, 18*time.Hour
+func NewWorkdayBusinessHours(loc *time.Location, begin, end time.Duration, holidays HolidayProvider) *BusinessHours {
+	b := NewBusinessHours(loc, holidays)
+	for w := time.Monday; w <= time.Friday; w++ {
+		b.AddInterval(int(w), begin, end)
+	}
+	return b
+}
+
+// AddInterval adds [begin, end) on weekday, intervals on the same weekday must not overlap
+func (b *BusinessHours) AddInterval(weekday int, begin, end time.Duration) {
+	if weekday < 0 || weekday > 6 {
+		panic(fmt.Sprintf("timex: invalid weekday %d", weekday))
+	}
+	if begin < 0 || end > Day || begin >= end {
+		panic(fmt.Sprintf("timex: invalid interval [%v, %v)", begin, end))
+	}
+	l := append(b.intervals[weekday], &DayInterval{Begin: begin, End: end})
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Begin < l[j].Begin
+	})
+	b.intervals[weekday] = l
+}
+
+// Intervals returns intervals of weekday
+func (b *BusinessHours) Intervals(weekday int) []*DayInterval {
+	return b.intervals[weekday%7]
+}
+
+func (b *BusinessHours) Location() *time.Location {
+	return b.location
+}
+
+// RangesOn returns working ranges on date d in schedule's time zone
+func (b *BusinessHours) RangesOn(d *Date) []*Range {
+	l := b.intervals[d.weekday]
+	if b.holidays != nil {
+		if w, ok := b.holidays.(MakeupWorkdayProvider); ok && w.IsMakeupWorkday(d) {
+			l = b.intervals[time.Monday]
+		} else if b.holidays.IsHoliday(d) {
+			return nil
+		}
+	}
+	res := make([]*Range, len(l))
+	for i, v := range l {
+		res[i] = NewRange(b.wallClock(d, v.Begin), b.wallClock(d, v.End))
+	}
+	return res
+}
+
+func (b *BusinessHours) wallClock(d *Date, offset time.Duration) time.Time {
+	// wall clock fields are normalized from nanoseconds, and DST gaps are shifted forward
+	return mustWallClock(d.year, d.month, d.day, 0, 0, 0, int(offset), b.location)
+}
+
+func (b *BusinessHours) hasIntervals() bool {
+	for _, l := range b.intervals {
+		if len(l) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Clip returns pieces of r which are in business hours
+func (b *BusinessHours) Clip(r *Range) []*Range {
+	var res []*Range
+	end := DateWithTime(r.end.In(b.location))
+	for d := DateWithTime(r.begin.In(b.location)); !d.After(end); d = d.Add(0, 0, 1) {
+		for _, v := range b.RangesOn(d) {
+			if !v.begin.Before(r.end) || !v.end.After(r.begin) {
+				continue
+			}
+			if v = v.Intersects(r); v != nil && v.Duration() > 0 {
+				res = append(res, v)
+			}
+		}
+	}
+	return res
+}
+
+// Duration returns business duration in r
+func (b *BusinessHours) Duration(r *Range) time.Duration {
+	var res time.Duration
+	for _, v := range b.Clip(r) {
+		res += v.Duration()
+	}
+	return res
+}
+
+// Add returns the time when business duration d elapses from t, e.g. SLA deadline
+func (b *BusinessHours) Add(t time.Time, d time.Duration) time.Time {
+	if d < 0 {
+		panic(fmt.Sprintf("timex: negative duration %v", d))
+	}
+	if !b.hasIntervals() {
+		panic("timex: no business hours")
+	}
+	date := DateWithTime(t.In(b.location))
+	// ten years without business hours must be a wrong schedule
+	for i := 0; i < 3660; i, date = i+1, date.Add(0, 0, 1) {
+		for _, v := range b.RangesOn(date) {
+			begin := v.begin
+			if t.After(begin) {
+				begin = t
+			}
+			if !begin.Before(v.end) {
+				continue
+			}
+			avail := v.end.Sub(begin)
+			if d <= avail {
+				return begin.Add(d).In(t.Location())
+			}
+			d -= avail
+		}
+	}
+	panic("timex: no business hours in ten years")
+}
@@ -0,0 +1,45 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusinessHours_Add(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	b := timex.NewWorkdayBusinessHours(loc, 9*time.Hour, 18*time.Hour, timex.NewCNStatutoryHolidayProvider())
+
+	// Friday 16:00 + 8h => Monday 15:00
+	start := time.Date(2026, 10, 16, 16, 0, 0, 0, loc)
+	assert.True(t, b.Add(start, 8*time.Hour).Equal(time.Date(2026, 10, 19, 15, 0, 0, 0, loc)))
+	// before opening
+	start = time.Date(2026, 10, 19, 7, 0, 0, 0, loc)
+	assert.True(t, b.Add(start, time.Hour).Equal(time.Date(2026, 10, 19, 10, 0, 0, 0, loc)))
+	// exactly at closing
+	assert.True(t, b.Add(start, 9*time.Hour).Equal(time.Date(2026, 10, 19, 18, 0, 0, 0, loc)))
+	// National Day holidays and make-up workday on Saturday 2026-10-10
+	start = time.Date(2026, 9, 30, 17, 0, 0, 0, loc)
+	assert.True(t, b.Add(start, 2*time.Hour).Equal(time.Date(2026, 10, 8, 10, 0, 0, 0, loc)))
+	start = time.Date(2026, 10, 9, 17, 0, 0, 0, loc)
+	assert.True(t, b.Add(start, 2*time.Hour).Equal(time.Date(2026, 10, 10, 10, 0, 0, 0, loc)))
+}
+
+func TestBusinessHours_Clip(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*3600)
+	b := timex.NewBusinessHours(loc, nil)
+	for w := 1; w <= 5; w++ {
+		b.AddInterval(w, 9*time.Hour, 12*time.Hour)
+		b.AddInterval(w, 13*time.Hour, 18*time.Hour)
+	}
+	// Friday 10:00 to Monday 14:00
+	r := timex.NewRange(time.Date(2026, 10, 16, 10, 0, 0, 0, loc), time.Date(2026, 10, 19, 14, 0, 0, 0, loc))
+	l := b.Clip(r)
+	require.Equal(t, 4, len(l))
+	assert.True(t, l[0].Begin().Equal(time.Date(2026, 10, 16, 10, 0, 0, 0, loc)))
+	assert.True(t, l[3].End().Equal(time.Date(2026, 10, 19, 14, 0, 0, 0, loc)))
+	assert.Equal(t, 2*time.Hour+5*time.Hour+3*time.Hour+time.Hour, b.Duration(r))
+}
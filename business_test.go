@@ -0,0 +1,70 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_AddBusinessDays(t *testing.T) {
+	// 2026-10-16 is Friday
+	d := timex.NewDate(2026, 10, 16)
+	assert.True(t, d.AddBusinessDays(1, nil).Equals(timex.NewDate(2026, 10, 19)))
+	assert.True(t, d.AddBusinessDays(5, nil).Equals(timex.NewDate(2026, 10, 23)))
+	assert.True(t, d.AddBusinessDays(-5, nil).Equals(timex.NewDate(2026, 10, 9)))
+	assert.True(t, d.AddBusinessDays(0, nil).Equals(d))
+
+	c := timex.NewBusinessCalendar(timex.FridaySaturday, nil)
+	assert.False(t, d.IsBusinessDay(c))
+	assert.True(t, d.AddBusinessDays(1, c).Equals(timex.NewDate(2026, 10, 18)))
+
+	c = timex.NewBusinessCalendar(timex.SundayOnly, nil)
+	assert.True(t, d.AddBusinessDays(1, c).Equals(timex.NewDate(2026, 10, 17)))
+
+	us := timex.NewBusinessCalendar(timex.SaturdaySunday, timex.NewUSHolidayProvider())
+	// Thanksgiving
+	assert.True(t, timex.NewDate(2026, 11, 25).AddBusinessDays(1, us).Equals(timex.NewDate(2026, 11, 27)))
+	assert.True(t, timex.NewDate(2026, 11, 26).NextBusinessDay(us).Equals(timex.NewDate(2026, 11, 27)))
+	assert.True(t, timex.NewDate(2026, 11, 27).PrevBusinessDay(us).Equals(timex.NewDate(2026, 11, 25)))
+
+	cn := timex.NewBusinessCalendar(timex.SaturdaySunday, timex.NewCNStatutoryHolidayProvider())
+	// 2026-02-13 is Friday, 02-14 is a make-up workday, then Spring Festival
+	assert.True(t, timex.NewDate(2026, 2, 13).AddBusinessDays(1, cn).Equals(timex.NewDate(2026, 2, 14)))
+	assert.True(t, timex.NewDate(2026, 2, 14).AddBusinessDays(1, cn).Equals(timex.NewDate(2026, 2, 24)))
+
+	// every day is weekend
+	c = timex.NewBusinessCalendar(timex.Weekend(1<<7-1), nil)
+	assert.Panics(t, func() {
+		d.AddBusinessDays(1, c)
+	})
+	assert.Panics(t, func() {
+		d.AddBusinessDays(-1, c)
+	})
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	begin, end := timex.NewDate(2026, 10, 1), timex.NewDate(2026, 11, 1)
+	assert.Equal(t, 22, timex.BusinessDaysBetween(begin, end, nil))
+	assert.Equal(t, -22, timex.BusinessDaysBetween(end, begin, nil))
+
+	r := timex.NewRange(begin.Begin(), end.Begin())
+	assert.Equal(t, 22, r.NumOfBusinessDays(nil))
+	cn := timex.NewBusinessCalendar(timex.SaturdaySunday, timex.NewCNStatutoryHolidayProvider())
+	// 5 days of National Day holidays on weekdays, one make-up workday on Saturday
+	assert.Equal(t, 18, r.NumOfBusinessDays(cn))
+	assert.Equal(t, 18, len(r.BusinessDays(cn)))
+}
+
+func TestDate_Roll(t *testing.T) {
+	// 2026-10-31 is Saturday
+	d := timex.NewDate(2026, 10, 31)
+	assert.True(t, d.Roll(timex.Following, nil).Equals(timex.NewDate(2026, 11, 2)))
+	assert.True(t, d.Roll(timex.ModifiedFollowing, nil).Equals(timex.NewDate(2026, 10, 30)))
+	assert.True(t, d.Roll(timex.Preceding, nil).Equals(timex.NewDate(2026, 10, 30)))
+	// 2026-11-01 is Sunday
+	d = timex.NewDate(2026, 11, 1)
+	assert.True(t, d.Roll(timex.ModifiedPreceding, nil).Equals(timex.NewDate(2026, 11, 2)))
+	d = timex.NewDate(2026, 10, 30)
+	assert.True(t, d.Roll(timex.Preceding, nil).Equals(d))
+}
@@ -13,14 +13,13 @@ const (
 	Weekly
 	Monthly
 	Yearly
+	// LunarYearly repeats on the same date of Chinese lunisolar calendar
+	LunarYearly
 )
 
-var enRepeats = []string{"Never", "Daily", "Weekly", "Monthly", "Yearly"}
-var zhHansRepeats = []string{"不重复", "每天", "每年", "每月", "每年"}
-
 func (r Repeat) IsValid() bool {
 	switch r {
-	case Never, Daily, Weekly, Monthly, Yearly:
+	case Never, Daily, Weekly, Monthly, Yearly, LunarYearly:
 		return true
 	default:
 		return false
@@ -28,42 +27,21 @@ func (r Repeat) IsValid() bool {
 }
 
 func (r Repeat) String() string {
-	if r < Never || r > Yearly {
+	if r < Never || r > LunarYearly {
 		return fmt.Sprint(int(r))
 	}
-	if IsSimplifiedChinese() {
-		return zhHansRepeats[r]
-	}
-	return enRepeats[r]
+	return CurrentLocale().Name("repeats", int(r))
 }
 
-type langT int
-
-const (
-	english = iota
-	simplifiedChinese
-	traditionalChinese
-)
-
-var lang langT = english
-
+// SetLang selects locale bundle by BCP 47 language tag, e.g. en-US, zh_CN, zh-Hant-TW, zh_HK, ja-JP, ko
 func SetLang(l string) {
-	l = strings.ToLower(l)
-	strings.Replace(l, "-", "_", -1)
-	switch {
-	case strings.Contains(l, "hans"):
-		lang = simplifiedChinese
-	case strings.Contains(l, "zh_cn"):
-		lang = simplifiedChinese
-	case strings.Contains(l, "zh_sg"):
-		lang = simplifiedChinese
-	}
+	setCurrentLocale(GetLocale(l))
 }
 
 func IsSimplifiedChinese() bool {
-	return lang == simplifiedChinese
+	return strings.HasPrefix(CurrentLocale().Tag, "zh-Hans")
 }
 
 func IsTraditionalChinese() bool {
-	return lang == traditionalChinese
+	return strings.HasPrefix(CurrentLocale().Tag, "zh-Hant")
 }
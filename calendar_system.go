@@ -0,0 +1,199 @@
+package timex
+
+import (
+	"fmt"
+)
+
+// CalendarSystem converts dates between Gregorian calendar and another calendar system.
+// Months are numbered from 1 in the order they occur in a year.
+type CalendarSystem interface {
+	// ID returns identifier of the system, e.g. gregorian, islamic-civil, persian, hebrew
+	ID() string
+	// FromDate converts d into the system, or returns an error if d is out of the range of the system
+	FromDate(d *Date) (*CalendarDate, error)
+	ToDate(year, month, day int) (*Date, error)
+	IsLeapYear(year int) bool
+	NumOfMonths(year int) int
+	NumOfMonthDays(year, month int) int
+	// MonthName returns month name in current language
+	MonthName(year, month int) string
+}
+
+// CalendarDate is a date in a calendar system
+type CalendarDate struct {
+	System CalendarSystem `json:"-"`
+	Year   int            `json:"year"`
+	Month  int            `json:"month"`
+	Day    int            `json:"day"`
+}
+
+func (c *CalendarDate) Date() *Date {
+	d, err := c.System.ToDate(c.Year, c.Month, c.Day)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (c *CalendarDate) MonthName() string {
+	return c.System.MonthName(c.Year, c.Month)
+}
+
+func (c *CalendarDate) String() string {
+	return fmt.Sprintf("%d-%d-%d", c.Year, c.Month, c.Day)
+}
+
+// PrettyText returns text like 15 Ramadan 1447
+func (c *CalendarDate) PrettyText() string {
+	return expandPattern(CurrentLocale().Pattern("calendar_date"), fmt.Sprint(c.Day), c.MonthName(), fmt.Sprint(c.Year))
+}
+
+// In converts d into calendar system s. It panics if d is out of the range of s, call s.FromDate to check the error.
+func (d *Date) In(s CalendarSystem) *CalendarDate {
+	c, err := s.FromDate(d)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func checkCalendarDate(s CalendarSystem, year, month, day int) error {
+	if month < 1 || month > s.NumOfMonths(year) {
+		return fmt.Errorf("invalid month %d in %s year %d", month, s.ID(), year)
+	}
+	if n := s.NumOfMonthDays(year, month); day < 1 || day > n {
+		return fmt.Errorf("invalid day %d, %s month %d-%d has %d days", day, s.ID(), year, month, n)
+	}
+	return nil
+}
+
+// julianDayNumber returns Julian Day Number of Gregorian date
+func julianDayNumber(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// dateOfJulianDayNumber returns Gregorian date of Julian Day Number
+func dateOfJulianDayNumber(jdn int) *Date {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day := e - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := 100*b + d - 4800 + m/10
+	return NewDate(year, month, day)
+}
+
+// localizedName returns the i-th name of list key in current locale
+func localizedName(key string, i int) string {
+	return CurrentLocale().Name(key, i)
+}
+
+type gregorianSystem struct{}
+
+// Gregorian is the calendar system of Date
+var Gregorian CalendarSystem = gregorianSystem{}
+
+func (gregorianSystem) ID() string {
+	return "gregorian"
+}
+
+func (s gregorianSystem) FromDate(d *Date) (*CalendarDate, error) {
+	return &CalendarDate{System: s, Year: d.year, Month: d.month, Day: d.day}, nil
+}
+
+func (s gregorianSystem) ToDate(year, month, day int) (*Date, error) {
+	if err := checkCalendarDate(s, year, month, day); err != nil {
+		return nil, err
+	}
+	return NewDate(year, month, day), nil
+}
+
+func (gregorianSystem) IsLeapYear(year int) bool {
+	return IsLeap(year)
+}
+
+func (gregorianSystem) NumOfMonths(year int) int {
+	return 12
+}
+
+func (gregorianSystem) NumOfMonthDays(year, month int) int {
+	return NewMonth(year, month).NumOfDays()
+}
+
+func (gregorianSystem) MonthName(year, month int) string {
+	return CurrentLocale().MonthName(month, Wide)
+}
+
+// SystemMonth is a month in a calendar system
+type SystemMonth struct {
+	System CalendarSystem `json:"-"`
+	Year   int            `json:"year"`
+	Month  int            `json:"month"`
+}
+
+func NewSystemMonth(s CalendarSystem, year, month int) *SystemMonth {
+	if month < 1 || month > s.NumOfMonths(year) {
+		panic(fmt.Sprintf("timex: invalid month %d in %s year %d", month, s.ID(), year))
+	}
+	return &SystemMonth{
+		System: s,
+		Year:   year,
+		Month:  month,
+	}
+}
+
+func (m *SystemMonth) NumOfDays() int {
+	return m.System.NumOfMonthDays(m.Year, m.Month)
+}
+
+// Date returns Gregorian date of day in the month
+func (m *SystemMonth) Date(day int) *Date {
+	d, err := m.System.ToDate(m.Year, m.Month, day)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (m *SystemMonth) Range() *Range {
+	// days may be skipped in a month, e.g. Gregorian reform, so count from the first day
+	first := m.Date(1)
+	return NewRange(first.Begin(), first.Add(0, 0, m.NumOfDays()).Begin())
+}
+
+func (m *SystemMonth) Name() string {
+	return m.System.MonthName(m.Year, m.Month)
+}
+
+// Add returns the month after months, which may be negative
+func (m *SystemMonth) Add(months int) *SystemMonth {
+	y, mo := m.Year, m.Month
+	for ; months > 0; months-- {
+		if mo++; mo > m.System.NumOfMonths(y) {
+			y++
+			mo = 1
+		}
+	}
+	for ; months < 0; months++ {
+		if mo--; mo < 1 {
+			y--
+			mo = m.System.NumOfMonths(y)
+		}
+	}
+	return NewSystemMonth(m.System, y, mo)
+}
+
+// Grid returns calendar grid of the month. Cell dates are Gregorian dates, convert them with Date.In to display days.
+func (m *SystemMonth) Grid(opts *GridOptions) [][7]*GridCell {
+	return buildGrid(m.Date(1), m.NumOfDays(), opts)
+}
+
+func (m *SystemMonth) String() string {
+	return fmt.Sprintf("%s %d-%d", m.System.ID(), m.Year, m.Month)
+}
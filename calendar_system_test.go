@@ -0,0 +1,118 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarSystem(t *testing.T) {
+	cases := []struct {
+		System timex.CalendarSystem
+		Date   *timex.Date
+		Year   int
+		Month  int
+		Day    int
+	}{
+		{timex.Gregorian, timex.NewDate(2026, 10, 19), 2026, 10, 19},
+		// 1 Ramadan 1447
+		{timex.IslamicTabular, timex.NewDate(2026, 2, 18), 1447, 9, 1},
+		{timex.IslamicTabular, timex.NewDate(622, 7, 19), 1, 1, 1},
+		// Nowruz
+		{timex.Persian, timex.NewDate(2024, 3, 20), 1403, 1, 1},
+		{timex.Persian, timex.NewDate(2025, 3, 21), 1404, 1, 1},
+		{timex.Persian, timex.NewDate(2025, 3, 20), 1403, 12, 30},
+		// Rosh Hashanah
+		{timex.Hebrew, timex.NewDate(2023, 9, 16), 5784, 1, 1},
+		{timex.Hebrew, timex.NewDate(2024, 10, 3), 5785, 1, 1},
+		{timex.Hebrew, timex.NewDate(2025, 9, 23), 5786, 1, 1},
+		// Passover 5784 on 15 Nisan in a leap year
+		{timex.Hebrew, timex.NewDate(2024, 4, 23), 5784, 8, 15},
+	}
+	for _, c := range cases {
+		v := c.Date.In(c.System)
+		assert.Equal(t, []int{c.Year, c.Month, c.Day}, []int{v.Year, v.Month, v.Day}, c.System.ID()+" "+c.Date.String())
+		d, err := c.System.ToDate(c.Year, c.Month, c.Day)
+		require.NoError(t, err)
+		assert.True(t, d.Equals(c.Date), c.System.ID()+" "+d.String())
+	}
+
+	// CLDR islamic-civil counts from Friday July 16, 622 (Julian), islamic-tbla from the day before
+	assert.Equal(t, "islamic-civil", timex.IslamicTabular.ID())
+	c := timex.NewDate(2026, 10, 19).In(timex.IslamicTabular)
+	assert.Equal(t, []int{1448, 5, 7}, []int{c.Year, c.Month, c.Day})
+
+	_, err := timex.IslamicTabular.ToDate(1447, 13, 1)
+	assert.Error(t, err)
+	_, err = timex.Hebrew.ToDate(5785, 13, 1)
+	assert.Error(t, err)
+	assert.True(t, timex.Hebrew.IsLeapYear(5784))
+	assert.True(t, timex.Persian.IsLeapYear(1403))
+	assert.False(t, timex.Persian.IsLeapYear(1404))
+}
+
+func TestPersian_Range(t *testing.T) {
+	first, err := timex.Persian.ToDate(-60, 1, 1)
+	require.NoError(t, err)
+	c, err := timex.Persian.FromDate(first)
+	require.NoError(t, err)
+	assert.Equal(t, []int{-60, 1, 1}, []int{c.Year, c.Month, c.Day})
+	_, err = timex.Persian.FromDate(first.Add(0, 0, -1))
+	assert.Error(t, err)
+
+	last, err := timex.Persian.ToDate(3177, 12, timex.Persian.NumOfMonthDays(3177, 12))
+	require.NoError(t, err)
+	assert.Equal(t, 3799, last.Year())
+	c, err = timex.Persian.FromDate(last)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3177, 12}, []int{c.Year, c.Month})
+	_, err = timex.Persian.FromDate(last.Add(0, 0, 1))
+	assert.Error(t, err)
+
+	_, err = timex.Persian.FromDate(timex.NewDate(1, 1, 1))
+	assert.Error(t, err)
+	_, err = timex.Persian.ToDate(3178, 1, 1)
+	assert.Error(t, err)
+	assert.Panics(t, func() {
+		timex.NewDate(1, 1, 1).In(timex.Persian)
+	})
+}
+
+func TestCalendarSystem_RoundTrip(t *testing.T) {
+	systems := []timex.CalendarSystem{timex.Gregorian, timex.IslamicTabular, timex.Persian, timex.Hebrew}
+	for _, s := range systems {
+		prev := timex.NewDate(1999, 12, 31).In(s)
+		for d := timex.NewDate(2000, 1, 1); d.Year() < 2040; d = d.Add(0, 0, 1) {
+			v := d.In(s)
+			require.True(t, v.Date().Equals(d), s.ID()+" "+d.String())
+			if v.Day != 1 {
+				require.Equal(t, prev.Day+1, v.Day, s.ID()+" "+d.String())
+			} else {
+				require.Equal(t, s.NumOfMonthDays(prev.Year, prev.Month), prev.Day, s.ID()+" "+d.String())
+			}
+			prev = v
+		}
+	}
+}
+
+func TestSystemMonth(t *testing.T) {
+	m := timex.NewSystemMonth(timex.IslamicTabular, 1447, 9)
+	assert.Equal(t, 30, m.NumOfDays())
+	assert.True(t, m.Date(1).Equals(timex.NewDate(2026, 2, 18)))
+	assert.Equal(t, "Ramadan", m.Name())
+	assert.Equal(t, "islamic-civil 1448-1", m.Add(4).String())
+	assert.Equal(t, "islamic-civil 1446-12", m.Add(-9).String())
+
+	g := m.Grid(&timex.GridOptions{})
+	// 2026-02-18 is Wednesday
+	assert.True(t, g[0][3].InMonth)
+	assert.Equal(t, 1, g[0][3].Date.In(timex.IslamicTabular).Day)
+	assert.False(t, g[0][2].InMonth)
+
+	h := timex.NewSystemMonth(timex.Hebrew, 5784, 6)
+	assert.Equal(t, "Adar I", h.Name())
+	assert.Equal(t, "Adar", timex.NewSystemMonth(timex.Hebrew, 5785, 6).Name())
+	assert.Equal(t, "hebrew 5785-1", h.Add(8).String())
+}
@@ -0,0 +1,152 @@
+package timex
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// MakeupWorkdayProvider marks days which are working days though they fall on weekend, e.g. make-up workdays (调休) in China
+type MakeupWorkdayProvider interface {
+	IsMakeupWorkday(d *Date) bool
+}
+
+// cnHolidaysData is the table of holidays and make-up workdays announced by the General Office of the State Council.
+// Append new years to cn_holidays.json when announced, or load them at runtime with CNStatutoryHolidayProvider.Load.
+//
+//go:embed cn_holidays.json
+var cnHolidaysData []byte
+
+type cnHolidayEntry struct {
+	Year     int     `json:"year"`
+	En       string  `json:"en"`
+	ZhHans   string  `json:"zh-Hans"`
+	ZhHant   string  `json:"zh-Hant"`
+	Begin    *Date   `json:"begin"`
+	End      *Date   `json:"end"`
+	Workdays []*Date `json:"workdays"`
+}
+
+// CNStatutoryHolidayProvider provides holidays and make-up workdays announced yearly by the State Council of China.
+// Years not in the table have no holidays.
+type CNStatutoryHolidayProvider struct {
+	mu       sync.RWMutex
+	years    map[int][]*Holiday
+	holidays map[Date]*Holiday
+	// make-up workday => year of table
+	workdays map[Date]int
+}
+
+var (
+	_ HolidayProvider       = (*CNStatutoryHolidayProvider)(nil)
+	_ MakeupWorkdayProvider = (*CNStatutoryHolidayProvider)(nil)
+)
+
+// NewCNStatutoryHolidayProvider creates a provider with the embedded table
+func NewCNStatutoryHolidayProvider() *CNStatutoryHolidayProvider {
+	p := &CNStatutoryHolidayProvider{
+		years:    make(map[int][]*Holiday),
+		holidays: make(map[Date]*Holiday),
+		workdays: make(map[Date]int),
+	}
+	if err := p.Load(cnHolidaysData); err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Load loads a JSON table in the same format as the embedded one. Years in data replace existing ones.
+func (p *CNStatutoryHolidayProvider) Load(data []byte) error {
+	var entries []*cnHolidayEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("unmarshal: %w", err)
+	}
+	years := make(map[int][]*cnHolidayEntry)
+	for _, e := range entries {
+		if e.Begin == nil || e.End == nil || e.End.Before(e.Begin) {
+			return fmt.Errorf("invalid holiday %s in %d", e.En, e.Year)
+		}
+		years[e.Year] = append(years[e.Year], e)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for year, l := range years {
+		p.remove(year)
+		var holidays []*Holiday
+		for _, e := range l {
+			names := names(e.En, e.ZhHans)
+			if e.ZhHant != "" {
+				names["zh-Hant"] = e.ZhHant
+			}
+			for d := e.Begin; !d.After(e.End); d = d.Add(0, 0, 1) {
+				h := &Holiday{Date: d, ActualDate: d, Names: names}
+				holidays = append(holidays, h)
+				p.holidays[dateKey(d)] = h
+			}
+			for _, d := range e.Workdays {
+				p.workdays[dateKey(d)] = year
+			}
+		}
+		sortHolidays(holidays)
+		p.years[year] = holidays
+	}
+	return nil
+}
+
+func (p *CNStatutoryHolidayProvider) remove(year int) {
+	for _, h := range p.years[year] {
+		delete(p.holidays, dateKey(h.Date))
+	}
+	for d, y := range p.workdays {
+		if y == year {
+			delete(p.workdays, d)
+		}
+	}
+	delete(p.years, year)
+}
+
+// Years returns years in the table
+func (p *CNStatutoryHolidayProvider) Years() []int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	l := make([]int, 0, len(p.years))
+	for y := range p.years {
+		l = append(l, y)
+	}
+	sort.Ints(l)
+	return l
+}
+
+// Holidays returns holidays announced for the year, which may include days in adjacent years
+func (p *CNStatutoryHolidayProvider) Holidays(year int) []*Holiday {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.years[year]
+}
+
+func (p *CNStatutoryHolidayProvider) Holiday(d *Date) *Holiday {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.holidays[dateKey(d)]
+}
+
+func (p *CNStatutoryHolidayProvider) IsHoliday(d *Date) bool {
+	return p.Holiday(d) != nil
+}
+
+func (p *CNStatutoryHolidayProvider) HolidayName(d *Date) string {
+	if h := p.Holiday(d); h != nil {
+		return h.Name()
+	}
+	return ""
+}
+
+func (p *CNStatutoryHolidayProvider) IsMakeupWorkday(d *Date) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.workdays[dateKey(d)]
+	return ok
+}
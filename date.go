@@ -4,9 +4,9 @@ import (
 	"database/sql/driver"
 	"encoding"
 	"fmt"
+	"strings"
+	"sync/atomic"
 	"time"
-
-	"github.com/gopub/conv"
 )
 
 var (
@@ -24,7 +24,16 @@ type Date struct {
 }
 
 func NewDate(year, month, day int) *Date {
-	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.Local)
+	return NewDateIn(year, month, day, time.Local)
+}
+
+// NewDateIn creates a date whose Begin and End are in time zone loc
+func NewDateIn(year, month, day int, loc *time.Location) *Date {
+	if loc == nil {
+		panic("timex: nil location")
+	}
+	// noon always exists, while midnight may be skipped by DST
+	t := time.Date(year, time.Month(month), day, 12, 0, 0, 0, loc)
 	return DateWithTime(t)
 }
 
@@ -39,12 +48,18 @@ func DateWithTime(t time.Time) *Date {
 		month:   int(t.Month()),
 		day:     t.Day(),
 		weekday: int(t.Weekday()),
-		t:       time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()),
+		// midnight may not exist on a DST transition day, then the day begins after the gap
+		t: mustWallClock(t.Year(), int(t.Month()), t.Day(), 0, 0, 0, 0, t.Location()),
 	}
 }
 
 func Today() *Date {
-	return DateWithTime(time.Now())
+	return TodayIn(time.Local)
+}
+
+// TodayIn returns today's date in time zone loc
+func TodayIn(loc *time.Location) *Date {
+	return DateWithTime(time.Now().In(loc))
 }
 
 func Tomorrow() *Date {
@@ -71,17 +86,27 @@ func (d *Date) Weekday() int {
 	return d.weekday
 }
 
+// ISOWeek returns the ISO 8601 year and week number
+func (d *Date) ISOWeek() (year, week int) {
+	return d.t.ISOWeek()
+}
+
+func (d *Date) IsWeekend() bool {
+	return d.weekday == int(time.Saturday) || d.weekday == int(time.Sunday)
+}
+
 func (d *Date) Unix() int64 {
 	return d.t.Unix()
 }
 
 func (d *Date) Add(years, months, days int) *Date {
-	return DateWithTime(d.t.AddDate(years, months, days))
+	return DateWithTime(time.Date(d.year+years, time.Month(d.month+months), d.day+days, 12, 0, 0, 0, d.Location()))
 }
 
+// Time returns wall clock time on d. Nonexistent times in DST gaps are shifted forward, ambiguous times use the earlier instant.
 func (d *Date) Time(hours, minutes int) *Time {
 	return &Time{
-		t: d.t.Add(time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute),
+		t: mustWallClock(d.year, d.month, d.day, hours, minutes, 0, 0, d.Location()),
 	}
 }
 
@@ -97,67 +122,77 @@ func (d *Date) After(date *Date) bool {
 	return d.Unix() > date.Unix()
 }
 
+// Location returns time zone of Begin and End
+func (d *Date) Location() *time.Location {
+	return d.t.Location()
+}
+
+// InLocation returns the same calendar date in time zone loc
+func (d *Date) InLocation(loc *time.Location) *Date {
+	return NewDateIn(d.year, d.month, d.day, loc)
+}
+
 func (d *Date) Begin() time.Time {
 	return d.t
 }
 
+// End returns the last nanosecond of the day
 func (d *Date) End() time.Time {
-	return time.Date(d.year, time.Month(d.month), d.day, 23, 59, 59, 999999999, d.t.Location())
+	return d.Add(0, 0, 1).Begin().Add(-time.Nanosecond)
 }
 
+// IsToday checks d with today in d's time zone
 func (d *Date) IsToday() bool {
-	return Today().Equals(d)
+	return TodayIn(d.Location()).Equals(d)
 }
 
 func (d *Date) IsTomorrow() bool {
-	return Tomorrow().Equals(d)
+	return TodayIn(d.Location()).Add(0, 0, 1).Equals(d)
 }
 
 func (d *Date) IsYesterday() bool {
-	return Yesterday().Equals(d)
+	return TodayIn(d.Location()).Add(0, 0, -1).Equals(d)
 }
 
 func (d *Date) String() string {
 	return fmt.Sprintf("%d/%d/%d", d.year, d.month, d.day)
 }
 
+// PrettyText returns month and day, with year if it's not this year, e.g. Oct 19, Oct 19, 2025
 func (d *Date) PrettyText() string {
-	var s string
-	if IsSimplifiedChinese() {
-		s = fmt.Sprintf("%d月%d日", d.month, d.day)
-	} else {
-		s = fmt.Sprintf("%s %d", time.Month(d.month).String()[:3], d.day)
-	}
-	if d.year == time.Now().Year() {
-		return s
-	}
-	if IsSimplifiedChinese() {
-		return fmt.Sprintf("%d年%s", d.year, s)
-	}
-	return fmt.Sprintf("%s, %d", s, d.year)
+	return defaultFormatter.PrettyText(d)
+}
+
+// Format returns a textual representation of the date with layout defined in package time
+func (d *Date) Format(layout string) string {
+	return d.t.Format(layout)
 }
 
 func (d *Date) MarshalText() (text []byte, err error) {
-	return []byte(d.String()), nil
+	return []byte(d.Format(getDateLayout())), nil
 }
 
 func (d *Date) UnmarshalText(text []byte) error {
-	s := string(text)
-	t, err := time.Parse("2006-01-02", s)
+	v, err := parseDateText(string(text))
 	if err != nil {
 		return err
 	}
-	*d = *DateWithTime(t)
+	*d = *v
 	return nil
 }
 
 func (d *Date) Scan(src interface{}) error {
-	t, ok := src.(time.Time)
-	if !ok {
-		return fmt.Errorf("expect time.Time instead of %T", src)
+	switch v := src.(type) {
+	case time.Time:
+		*d = *DateWithTime(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return fmt.Errorf("expect time.Time, string or []byte instead of %T", src)
 	}
-	*d = *DateWithTime(t)
-	return nil
 }
 
 func (d *Date) Value() (driver.Value, error) {
@@ -167,6 +202,53 @@ func (d *Date) Value() (driver.Value, error) {
 	return d.t, nil
 }
 
+// ISO8601DateLayout is the default layout of Date.MarshalText
+const ISO8601DateLayout = "2006-01-02"
+
+// dateLayout holds the layout string set by SetDateLayout
+var dateLayout atomic.Value
+
+func getDateLayout() string {
+	if layout, ok := dateLayout.Load().(string); ok {
+		return layout
+	}
+	return ISO8601DateLayout
+}
+
+// SetDateLayout sets the layout used by Date.MarshalText. UnmarshalText accepts it as well as ISO 8601, slash and compact forms.
+// ISO 8601 is tried first, so text matching both, e.g. 2026-05-06 for layout 2006-02-01, is read as ISO 8601.
+func SetDateLayout(layout string) {
+	if layout == "" {
+		layout = ISO8601DateLayout
+	}
+	dateLayout.Store(layout)
+}
+
+var dateTextLayouts = []string{
+	ISO8601DateLayout,
+	"2006/1/2",
+	"20060102",
+	time.RFC3339Nano,
+}
+
+func parseDateText(s string) (*Date, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.ParseInLocation(ISO8601DateLayout, s, time.Local); err == nil {
+		return DateWithTime(t), nil
+	}
+	if layout := getDateLayout(); layout != ISO8601DateLayout {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return DateWithTime(t), nil
+		}
+	}
+	for _, layout := range dateTextLayouts[1:] {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return DateWithTime(t), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot parse %q as date", s)
+}
+
 func (d *Date) NextRepeat(r Repeat) *Date {
 	switch r {
 	case Daily:
@@ -177,59 +259,24 @@ func (d *Date) NextRepeat(r Repeat) *Date {
 		return d.Add(0, 1, 0)
 	case Yearly:
 		return d.Add(1, 0, 0)
+	case LunarYearly:
+		if l := d.Lunar(); l != nil {
+			return NewLunarRecurrence(l).Next(d)
+		}
+		return nil
 	default:
 		return nil
 	}
 }
 
+// ShortText returns relative day word like Today, or weekday and PrettyText
 func (d *Date) ShortText() string {
-	if conv.AbsDuration(d.t.Sub(time.Now())) <= 2*Day {
-		if IsSimplifiedChinese() {
-			switch {
-			case d.IsYesterday():
-				return "昨天"
-			case d.IsToday():
-				return "今天"
-			case d.IsTomorrow():
-				return "明天"
-			}
-		} else {
-			switch {
-			case d.IsYesterday():
-				return "Yesterday"
-			case d.IsToday():
-				return "Today"
-			case d.IsTomorrow():
-				return "Tomorrow"
-			}
-		}
-	}
-	return fmt.Sprintf("%s %s", GetWeekdaySymbol(d.weekday), d.PrettyText())
+	return defaultFormatter.ShortText(d)
 }
 
+// LongText returns relative day word or weekday, followed by PrettyText
 func (d *Date) LongText() string {
-	if conv.AbsDuration(d.t.Sub(time.Now())) <= 2*Day {
-		if IsSimplifiedChinese() {
-			switch {
-			case d.IsYesterday():
-				return "昨天 " + d.PrettyText()
-			case d.IsToday():
-				return "今天 " + d.PrettyText()
-			case d.IsTomorrow():
-				return "明天 " + d.PrettyText()
-			}
-		} else {
-			switch {
-			case d.IsYesterday():
-				return "Yesterday " + d.PrettyText()
-			case d.IsToday():
-				return "Today " + d.PrettyText()
-			case d.IsTomorrow():
-				return "Tomorrow " + d.PrettyText()
-			}
-		}
-	}
-	return fmt.Sprintf("%s %s", GetWeekdaySymbol(d.weekday), d.PrettyText())
+	return defaultFormatter.LongText(d)
 }
 
 func (d *Date) Range() *Range {
@@ -0,0 +1,96 @@
+package timex_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate_JSON(t *testing.T) {
+	d := timex.NewDate(2026, 10, 17)
+	b, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"2026-10-17"`, string(b))
+
+	var v *timex.Date
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.True(t, d.Equals(v))
+
+	for _, s := range []string{`"2026/10/17"`, `"2026/1/7"`, `"20261017"`, `"2026-10-17T08:00:00+08:00"`} {
+		var v timex.Date
+		require.NoError(t, json.Unmarshal([]byte(s), &v), s)
+		assert.Equal(t, 2026, v.Year(), s)
+	}
+	assert.Error(t, json.Unmarshal([]byte(`"17 Oct"`), &v))
+
+	timex.SetDateLayout("02.01.2006")
+	defer timex.SetDateLayout("")
+	b, err = json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"17.10.2026"`, string(b))
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.True(t, d.Equals(v))
+}
+
+func TestSetDateLayout_ISOFirst(t *testing.T) {
+	timex.SetDateLayout("2006-02-01")
+	defer timex.SetDateLayout("")
+	d := timex.NewDate(2026, 10, 17)
+	b, err := d.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-17-10", string(b))
+
+	var v timex.Date
+	require.NoError(t, v.UnmarshalText(b))
+	assert.True(t, d.Equals(&v))
+	require.NoError(t, v.UnmarshalText([]byte("2026-10-17")))
+	assert.True(t, d.Equals(&v))
+	require.NoError(t, v.UnmarshalText([]byte("2026-05-06")))
+	assert.True(t, timex.NewDate(2026, 5, 6).Equals(&v))
+	// CN holidays are loaded from ISO dates
+	cn := timex.NewCNStatutoryHolidayProvider()
+	assert.True(t, cn.IsHoliday(timex.NewDate(2026, 5, 1)))
+	assert.False(t, cn.IsHoliday(timex.NewDate(2026, 1, 5)))
+}
+
+func TestSetDateLayout_Concurrent(t *testing.T) {
+	defer timex.SetDateLayout("")
+	d := timex.NewDate(2026, 10, 17)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			timex.SetDateLayout("02.01.2006")
+			timex.SetDateLayout("")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			b, err := d.MarshalText()
+			assert.NoError(t, err)
+			assert.Contains(t, []string{"2026-10-17", "17.10.2026"}, string(b))
+		}
+	}()
+	wg.Wait()
+}
+
+func TestDate_Scan(t *testing.T) {
+	for _, src := range []interface{}{
+		time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+		"2026-10-17",
+		[]byte("2026-10-17"),
+	} {
+		var d timex.Date
+		require.NoError(t, d.Scan(src))
+		assert.True(t, d.Equals(timex.NewDate(2026, 10, 17)))
+	}
+	var d timex.Date
+	assert.Error(t, d.Scan(1))
+}
@@ -0,0 +1,134 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// GapPolicy resolves wall clock times which don't exist because clocks are set forward, e.g. 02:30 on the day DST begins in New York
+type GapPolicy int
+
+const (
+	// GapShiftForward shifts the time forward by the length of the gap, e.g. 02:30 becomes 03:30
+	GapShiftForward GapPolicy = iota
+	// GapNextValid uses the first valid instant after the gap, e.g. 02:30 becomes 03:00
+	GapNextValid
+	// GapError returns an error
+	GapError
+)
+
+// OverlapPolicy resolves wall clock times which occur twice because clocks are set back, e.g. 01:30 on the day DST ends in New York
+type OverlapPolicy int
+
+const (
+	// OverlapEarlier uses the earlier instant, which has the offset before the transition
+	OverlapEarlier OverlapPolicy = iota
+	// OverlapLater uses the later instant, which has the offset after the transition
+	OverlapLater
+	// OverlapError returns an error
+	OverlapError
+)
+
+// DSTPolicy resolves nonexistent and ambiguous wall clock times. The zero value shifts nonexistent times forward and uses the earlier of ambiguous times.
+type DSTPolicy struct {
+	Gap     GapPolicy
+	Overlap OverlapPolicy
+}
+
+var defaultDSTPolicy = &DSTPolicy{}
+
+// WallClockError is returned if a wall clock time is nonexistent or ambiguous and the policy requires an error
+type WallClockError struct {
+	Time     string
+	Location *time.Location
+	// IsAmbiguous is true if the time occurs twice, otherwise it doesn't exist
+	IsAmbiguous bool
+}
+
+func (e *WallClockError) Error() string {
+	if e.IsAmbiguous {
+		return fmt.Sprintf("timex: %s is ambiguous in %s", e.Time, e.Location)
+	}
+	return fmt.Sprintf("timex: %s doesn't exist in %s", e.Time, e.Location)
+}
+
+// WallClock returns the instant of the wall clock time in loc, resolving DST transitions with policy p. Nil p means the zero policy.
+// Out of range values are normalized as time.Date does, e.g. hour 25 is 01:00 of the next day.
+func WallClock(year, month, day, hour, min, sec, nsec int, loc *time.Location, p *DSTPolicy) (time.Time, error) {
+	if p == nil {
+		p = defaultDSTPolicy
+	}
+	naive := time.Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
+	// transitions are more than a day apart, so offsets a day before and after cover both sides of any transition
+	_, before := naive.Add(-Day).In(loc).Zone()
+	_, after := naive.Add(Day).In(loc).Zone()
+	var valid []time.Time
+	for i, offset := range []int{before, after} {
+		if i == 1 && after == before {
+			break
+		}
+		t := naive.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, o := t.Zone(); o == offset {
+			valid = append(valid, t)
+		}
+	}
+	switch len(valid) {
+	case 1:
+		return valid[0], nil
+	case 2:
+		earlier, later := valid[0], valid[1]
+		if later.Before(earlier) {
+			earlier, later = later, earlier
+		}
+		switch p.Overlap {
+		case OverlapLater:
+			return later, nil
+		case OverlapError:
+			return time.Time{}, &WallClockError{Time: naive.Format("2006-01-02 15:04:05"), Location: loc, IsAmbiguous: true}
+		default:
+			return earlier, nil
+		}
+	}
+
+	// the wall clock is in a gap: interpreted with the offset before the gap, it's after the transition
+	shifted := naive.Add(-time.Duration(before) * time.Second).In(loc)
+	switch p.Gap {
+	case GapNextValid:
+		return transitionBefore(shifted, loc), nil
+	case GapError:
+		return time.Time{}, &WallClockError{Time: naive.Format("2006-01-02 15:04:05"), Location: loc}
+	default:
+		return shifted, nil
+	}
+}
+
+// transitionBefore returns the instant of the latest offset change before t, searching within a day
+func transitionBefore(t time.Time, loc *time.Location) time.Time {
+	_, offset := t.Zone()
+	lo, hi := t.Add(-Day), t
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if _, o := mid.In(loc).Zone(); o == offset {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi.Truncate(time.Second).In(loc)
+}
+
+// mustWallClock returns the wall clock time resolved with the zero policy
+func mustWallClock(year, month, day, hour, min, sec, nsec int, loc *time.Location) time.Time {
+	t, _ := WallClock(year, month, day, hour, min, sec, nsec, loc, nil)
+	return t
+}
+
+// At returns instant of the wall clock time on d in d's time zone, resolving DST transitions with policy p
+func (d *Date) At(hour, minute, second int, p *DSTPolicy) (time.Time, error) {
+	return WallClock(d.year, d.month, d.day, hour, minute, second, 0, d.Location(), p)
+}
+
+// Duration returns length of the day, which is 23 or 25 hours on DST transition days
+func (d *Date) Duration() time.Duration {
+	return d.Add(0, 0, 1).Begin().Sub(d.Begin())
+}
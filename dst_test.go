@@ -0,0 +1,96 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestWallClock(t *testing.T) {
+	ny := loadLocation(t, "America/New_York")
+	// 2026-03-08 02:00 EST jumps to 03:00 EDT
+	tm, err := timex.WallClock(2026, 3, 8, 2, 30, 0, 0, ny, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC), tm.UTC())
+	assert.Equal(t, 3, tm.Hour())
+	tm, err = timex.WallClock(2026, 3, 8, 2, 30, 0, 0, ny, &timex.DSTPolicy{Gap: timex.GapNextValid})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 8, 7, 0, 0, 0, time.UTC), tm.UTC())
+	_, err = timex.WallClock(2026, 3, 8, 2, 30, 0, 0, ny, &timex.DSTPolicy{Gap: timex.GapError})
+	assert.Error(t, err)
+
+	// 2026-11-01 02:00 EDT falls back to 01:00 EST
+	tm, err = timex.WallClock(2026, 11, 1, 1, 30, 0, 0, ny, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC), tm.UTC())
+	tm, err = timex.WallClock(2026, 11, 1, 1, 30, 0, 0, ny, &timex.DSTPolicy{Overlap: timex.OverlapLater})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC), tm.UTC())
+	_, err = timex.WallClock(2026, 11, 1, 1, 30, 0, 0, ny, &timex.DSTPolicy{Overlap: timex.OverlapError})
+	we, ok := err.(*timex.WallClockError)
+	require.True(t, ok)
+	assert.True(t, we.IsAmbiguous)
+
+	tm, err = timex.WallClock(2026, 7, 1, 9, 0, 0, 0, ny, &timex.DSTPolicy{Gap: timex.GapError, Overlap: timex.OverlapError})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 7, 1, 13, 0, 0, 0, time.UTC), tm.UTC())
+}
+
+func TestDST_Date(t *testing.T) {
+	london := loadLocation(t, "Europe/London")
+	// 2026-03-29 01:00 GMT jumps to 02:00 BST
+	d := timex.NewDateIn(2026, 3, 29, london)
+	assert.Equal(t, 23*time.Hour, d.Duration())
+	assert.Equal(t, 25*time.Hour, timex.NewDateIn(2026, 10, 25, london).Duration())
+	assert.Equal(t, 24*time.Hour, timex.NewDateIn(2026, 10, 26, london).Duration())
+
+	tm := d.Time(9, 0)
+	assert.Equal(t, 9, tm.Hour())
+	assert.Equal(t, time.Date(2026, 3, 29, 8, 0, 0, 0, time.UTC), time.Unix(tm.Unix(), 0).UTC())
+	assert.Equal(t, 2, d.Time(1, 30).Hour())
+	assert.Equal(t, time.Date(2026, 3, 29, 23, 59, 59, 999999999, london), d.End())
+
+	at, err := d.At(1, 30, 0, &timex.DSTPolicy{Gap: timex.GapError})
+	assert.Error(t, err)
+	assert.True(t, at.IsZero())
+
+	// midnight doesn't exist in Havana when DST begins
+	havana := loadLocation(t, "America/Havana")
+	d = timex.NewDateIn(2026, 3, 8, havana)
+	assert.Equal(t, 1, d.Begin().Hour())
+	assert.Equal(t, 23*time.Hour, d.Duration())
+}
+
+func TestDST_Range(t *testing.T) {
+	ny := loadLocation(t, "America/New_York")
+	d := timex.NewDateIn(2026, 3, 8, ny)
+	r := d.Range()
+	assert.Equal(t, 23*time.Hour, r.Duration())
+	assert.True(t, r.IsAllDay())
+	assert.True(t, timex.NewDateIn(2026, 11, 1, ny).Range().IsAllDay())
+	assert.False(t, timex.NewRange(d.Begin(), d.Begin().Add(24*time.Hour)).IsAllDay())
+
+	r = timex.NewRange(time.Date(2026, 3, 7, 12, 0, 0, 0, ny), time.Date(2026, 3, 9, 12, 0, 0, 0, ny))
+	l := r.SplitInDay()
+	require.Equal(t, 3, len(l))
+	assert.Equal(t, 12*time.Hour, l[0].Duration())
+	assert.True(t, l[1].IsAllDay())
+	assert.Equal(t, 23*time.Hour, l[1].Duration())
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, ny), l[2].Begin())
+	assert.Equal(t, 12*time.Hour, l[2].Duration())
+
+	r = timex.NewRange(time.Date(2026, 10, 31, 0, 0, 0, 0, ny), time.Date(2026, 11, 2, 0, 0, 0, 0, ny))
+	l = r.SplitInDay()
+	require.Equal(t, 2, len(l))
+	assert.Equal(t, 25*time.Hour, l[1].Duration())
+	assert.True(t, l[1].IsAllDay())
+}
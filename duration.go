@@ -0,0 +1,430 @@
+package timex
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationStyle is the length of unit names in duration text
+type DurationStyle int
+
+const (
+	// LongDuration spells units, e.g. 2 hours 30 minutes, 2小时30分钟
+	LongDuration DurationStyle = iota
+	// ShortDuration abbreviates units, e.g. 2h 30m, 2小时30分
+	ShortDuration
+	// NarrowDuration abbreviates units without spaces, e.g. 2h30m, 2时30分
+	NarrowDuration
+)
+
+func (s DurationStyle) String() string {
+	switch s {
+	case ShortDuration:
+		return "short"
+	case NarrowDuration:
+		return "narrow"
+	default:
+		return "long"
+	}
+}
+
+// DurationOptions controls DurationText and PeriodText
+type DurationOptions struct {
+	Style DurationStyle
+
+	// MaxUnits is the max number of units, e.g. 2 formats 1 day 2 hours 3 minutes as 1 day 2 hours. 0 is unlimited.
+	MaxUnits int
+
+	// Round rounds the smallest unit to nearest, otherwise it's truncated
+	Round bool
+
+	// MinUnit is the smallest unit: time.Millisecond, time.Second, time.Minute, time.Hour or Day. Default is time.Second.
+	MinUnit time.Duration
+}
+
+type durationUnit struct {
+	name  string
+	value time.Duration
+}
+
+// durationUnits are units of fixed length, weeks are not used because 10 days reads better than 1 week 3 days
+var durationUnits = []durationUnit{
+	{"day", Day},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+	{"millisecond", time.Millisecond},
+}
+
+// DurationText returns text of d in current language, e.g. 2 hours 30 minutes
+func DurationText(d time.Duration, opts *DurationOptions) string {
+	return defaultFormatter.DurationText(d, opts)
+}
+
+// PeriodText returns text of p in current language, e.g. 1 year 2 months
+func PeriodText(p *Period, opts *DurationOptions) string {
+	return defaultFormatter.PeriodText(p, opts)
+}
+
+// DurationText returns text of d with units from days to opts.MinUnit, e.g. 2 hours 30 minutes, 2h 30m, 2小时30分钟
+func (f *Formatter) DurationText(d time.Duration, opts *DurationOptions) string {
+	if opts == nil {
+		opts = &DurationOptions{}
+	}
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	l := f.locale()
+	return sign + joinDurationFields(l, opts.Style, durationFields(d, opts, opts.MaxUnits))
+}
+
+// PeriodText returns text of p's years, months and days, followed by text of its clock duration, e.g. 1 year 2 months 3 days.
+// Rounding only applies to the clock duration.
+func (f *Formatter) PeriodText(p *Period, opts *DurationOptions) string {
+	if opts == nil {
+		opts = &DurationOptions{}
+	}
+	p.checkSign()
+	if p.Clock == 0 && p.Years == 0 && p.Months == 0 && p.Days == 0 {
+		return f.DurationText(0, opts)
+	}
+	var fields []string
+	sign := 1
+	if p.isNegative() {
+		sign = -1
+	}
+	for _, v := range []struct {
+		name  string
+		value int
+	}{{"year", p.Years}, {"month", p.Months}, {"day", p.Days}} {
+		if v.value != 0 && (opts.MaxUnits <= 0 || len(fields) < opts.MaxUnits) {
+			fields = append(fields, f.locale().Plural(v.name+"_"+opts.Style.String(), sign*v.value))
+		}
+	}
+	if p.Clock != 0 && (opts.MaxUnits <= 0 || len(fields) < opts.MaxUnits) {
+		max := 0
+		if opts.MaxUnits > 0 {
+			max = opts.MaxUnits - len(fields)
+		}
+		for _, v := range durationFields(time.Duration(sign)*p.Clock, opts, max) {
+			fields = append(fields, f.locale().Plural(v.name+"_"+opts.Style.String(), int(v.value)))
+		}
+	}
+	s := strings.Join(fields, f.locale().Word("duration_separator_"+opts.Style.String()))
+	if sign < 0 {
+		return "-" + s
+	}
+	return s
+}
+
+// durationFields splits non-negative d into values of units, at most maxUnits if it's positive
+func durationFields(d time.Duration, opts *DurationOptions, maxUnits int) []durationUnit {
+	minUnit := opts.MinUnit
+	if minUnit <= 0 {
+		minUnit = time.Second
+	}
+	units := make([]durationUnit, 0, len(durationUnits))
+	for _, u := range durationUnits {
+		if u.value >= minUnit {
+			units = append(units, u)
+		}
+	}
+	if len(units) == 0 {
+		panic(fmt.Sprintf("timex: invalid min unit %v", opts.MinUnit))
+	}
+
+	// the smallest unit is limited by maxUnits counting from the largest unit of d
+	first := len(units) - 1
+	for i, u := range units {
+		if d >= u.value {
+			first = i
+			break
+		}
+	}
+	last := len(units) - 1
+	if maxUnits > 0 && first+maxUnits-1 < last {
+		last = first + maxUnits - 1
+	}
+	// rounding may carry into a larger unit, e.g. 59.6 minutes to 1 hour, which leaves fewer units
+	smallest := units[last].value
+	if opts.Round {
+		d = (d + smallest/2) / smallest * smallest
+	} else {
+		d = d / smallest * smallest
+	}
+
+	var fields []durationUnit
+	for _, u := range units[:last+1] {
+		if n := d / u.value; n > 0 {
+			fields = append(fields, durationUnit{u.name, n})
+			d -= n * u.value
+		}
+	}
+	if len(fields) == 0 {
+		fields = append(fields, durationUnit{units[last].name, 0})
+	}
+	return fields
+}
+
+func joinDurationFields(l *Locale, style DurationStyle, fields []durationUnit) string {
+	texts := make([]string, len(fields))
+	for i, v := range fields {
+		texts[i] = l.Plural(v.name+"_"+style.String(), int(v.value))
+	}
+	return strings.Join(texts, l.Word("duration_separator_"+style.String()))
+}
+
+// Period is an ISO 8601 duration, whose years, months and days vary in length, e.g. P1Y2M10DT2H30M.
+// ISO 8601 has a sign for the whole duration, so fields must not have different signs: NewPeriod, String and PeriodText panic
+// on mixed signs, e.g. 1 day and -1 hour.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+	// Clock is the time part, e.g. 2h30m of PT2H30M
+	Clock time.Duration
+}
+
+func NewPeriod(years, months, days int, clock time.Duration) *Period {
+	p := &Period{
+		Years:  years,
+		Months: months,
+		Days:   days,
+		Clock:  clock,
+	}
+	p.checkSign()
+	return p
+}
+
+// checkSign panics if p has both positive and negative fields
+func (p *Period) checkSign() {
+	if (p.Years > 0 || p.Months > 0 || p.Days > 0 || p.Clock > 0) &&
+		(p.Years < 0 || p.Months < 0 || p.Days < 0 || p.Clock < 0) {
+		panic(fmt.Sprintf("timex: mixed signs in period %dY%dM%dD%v", p.Years, p.Months, p.Days, p.Clock))
+	}
+}
+
+func (p *Period) isNegative() bool {
+	return p.Years <= 0 && p.Months <= 0 && p.Days <= 0 && p.Clock <= 0 &&
+		(p.Years < 0 || p.Months < 0 || p.Days < 0 || p.Clock < 0)
+}
+
+// AddTo returns t plus p in t's time zone, days are calendar days which may be 23 or 25 hours across DST transitions
+func (p *Period) AddTo(t time.Time) time.Time {
+	return t.AddDate(p.Years, p.Months, p.Days).Add(p.Clock)
+}
+
+// Duration returns p's length with Day as the length of a day. ok is false if p has years or months.
+func (p *Period) Duration() (d time.Duration, ok bool) {
+	if p.Years != 0 || p.Months != 0 {
+		return 0, false
+	}
+	return time.Duration(p.Days)*Day + p.Clock, true
+}
+
+// String returns ISO 8601 duration, e.g. P1Y2M10DT2H30M, -P1D, PT0S
+func (p *Period) String() string {
+	p.checkSign()
+	v := *p
+	var b strings.Builder
+	if v.isNegative() {
+		b.WriteString("-")
+		v = Period{Years: -v.Years, Months: -v.Months, Days: -v.Days, Clock: -v.Clock}
+	}
+	b.WriteString("P")
+	for _, f := range []struct {
+		value  int
+		letter string
+	}{{v.Years, "Y"}, {v.Months, "M"}, {v.Days, "D"}} {
+		if f.value != 0 {
+			b.WriteString(strconv.Itoa(f.value) + f.letter)
+		}
+	}
+	if v.Clock != 0 {
+		b.WriteString("T")
+		c := v.Clock
+		if h := c / time.Hour; h != 0 {
+			b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+			c -= h * time.Hour
+		}
+		if m := c / time.Minute; m != 0 {
+			b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+			c -= m * time.Minute
+		}
+		if c != 0 {
+			b.WriteString(strconv.FormatFloat(c.Seconds(), 'f', -1, 64) + "S")
+		}
+	}
+	if b.Len() <= 2 {
+		return "PT0S"
+	}
+	return b.String()
+}
+
+var isoDurationRegexp = regexp.MustCompile(`^([-+])?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// ParsePeriod parses ISO 8601 duration, e.g. P1Y2M10DT2H30M, P2W, PT1.5H, -P1D. Weeks are converted to days.
+func ParsePeriod(s string) (*Period, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	m := isoDurationRegexp.FindStringSubmatch(s)
+	if m == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return nil, fmt.Errorf("timex: invalid ISO 8601 duration %q", s)
+	}
+	p := new(Period)
+	p.Years, _ = strconv.Atoi(m[2])
+	p.Months, _ = strconv.Atoi(m[3])
+	weeks, _ := strconv.Atoi(m[4])
+	p.Days, _ = strconv.Atoi(m[5])
+	p.Days += weeks * 7
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if m[6+i] == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.Replace(m[6+i], ",", ".", 1), 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", m[6+i], err)
+		}
+		p.Clock += time.Duration(math.Round(v * float64(unit)))
+	}
+	if m[1] == "-" {
+		p = &Period{Years: -p.Years, Months: -p.Months, Days: -p.Days, Clock: -p.Clock}
+	}
+	return p, nil
+}
+
+// durationUnitNames maps unit names in supported languages to units
+var durationUnitNames = map[string]time.Duration{
+	"ns": time.Nanosecond, "nanosecond": time.Nanosecond, "nanoseconds": time.Nanosecond,
+	"us": time.Microsecond, "µs": time.Microsecond, "microsecond": time.Microsecond, "microseconds": time.Microsecond,
+	"ms": time.Millisecond, "msec": time.Millisecond, "millisecond": time.Millisecond, "milliseconds": time.Millisecond,
+	"毫秒": time.Millisecond, "ミリ秒": time.Millisecond, "밀리초": time.Millisecond,
+	"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+	"秒": time.Second, "秒钟": time.Second, "秒鐘": time.Second, "秒間": time.Second, "초": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"分": time.Minute, "分钟": time.Minute, "分鐘": time.Minute, "分間": time.Minute, "분": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"小时": time.Hour, "小時": time.Hour, "时": time.Hour, "時": time.Hour, "钟头": time.Hour, "鐘頭": time.Hour,
+	"時間": time.Hour, "시간": time.Hour,
+	"d": Day, "day": Day, "days": Day, "天": Day, "日": Day, "日間": Day, "일": Day,
+	"w": Week, "wk": Week, "wks": Week, "week": Week, "weeks": Week,
+	"周": Week, "週": Week, "星期": Week, "礼拜": Week, "禮拜": Week, "週間": Week, "주": Week,
+}
+
+var (
+	durationFieldRegexp     = regexp.MustCompile(`^(\d+(?:\.\d+)?|\.\d+)\s*([^\d\s.,、]+)`)
+	durationSeparatorRegexp = regexp.MustCompile(`^(?:[\s,、]+|and\s+|又|零)`)
+	chineseNumberRegexp     = regexp.MustCompile(`[零〇一二两兩三四五六七八九十百千]+`)
+	halfAfterUnitRegexp     = regexp.MustCompile(`(\d+)([^\d\s.半]+)半`)
+	halfRegexp              = regexp.MustCompile(`(^|[^\d.])半`)
+	englishArticleRegexp    = regexp.MustCompile(`\b(?:an?|one)\s+`)
+	englishHalfRegexp       = regexp.MustCompile(`\bhalf\s+(?:an?\s+)?`)
+)
+
+// ParseDuration parses human-friendly or ISO 8601 duration, e.g. 1h30m, 1.5 hours, 90 min, 2 days 3 hours, an hour,
+// 一个半小时, 2小时30分钟, PT1H30M, P2D. Days are Day and weeks are Week long. Years and months are rejected as they vary in length.
+func ParseDuration(s string) (time.Duration, error) {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return 0, fmt.Errorf("timex: empty duration")
+	}
+	if strings.HasPrefix(strings.TrimLeft(text, "+-"), "P") || strings.HasPrefix(strings.TrimLeft(text, "+-"), "p") {
+		p, err := ParsePeriod(text)
+		if err != nil {
+			return 0, err
+		}
+		d, ok := p.Duration()
+		if !ok {
+			return 0, fmt.Errorf("timex: %q has years or months which vary in length", s)
+		}
+		return d, nil
+	}
+	if d, err := time.ParseDuration(text); err == nil {
+		return d, nil
+	}
+
+	sign := time.Duration(1)
+	if strings.HasPrefix(text, "-") {
+		sign = -1
+		text = strings.TrimSpace(text[1:])
+	}
+	text = normalizeDurationText(text)
+	var total time.Duration
+	fields := 0
+	for n := 0; text != ""; n++ {
+		if m := durationSeparatorRegexp.FindString(text); m != "" && n > 0 {
+			text = text[len(m):]
+			continue
+		}
+		m := durationFieldRegexp.FindStringSubmatch(text)
+		if m == nil {
+			return 0, fmt.Errorf("timex: invalid duration %q", s)
+		}
+		unit, ok := durationUnitNames[m[2]]
+		if !ok {
+			return 0, fmt.Errorf("timex: unknown unit %q in duration %q", m[2], s)
+		}
+		v, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse %s: %w", m[1], err)
+		}
+		total += time.Duration(math.Round(v * float64(unit)))
+		text = text[len(m[0]):]
+		fields++
+	}
+	if fields == 0 {
+		return 0, fmt.Errorf("timex: invalid duration %q", s)
+	}
+	return sign * total, nil
+}
+
+// normalizeDurationText converts words to digits, e.g. an hour to 1 hour, 一个半小时 to 1.5小时
+func normalizeDurationText(s string) string {
+	s = strings.ToLower(s)
+	s = englishHalfRegexp.ReplaceAllString(s, "0.5 ")
+	s = englishArticleRegexp.ReplaceAllString(s, "1 ")
+	s = chineseNumberRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		return strconv.Itoa(parseChineseNumber(v))
+	})
+	s = strings.Replace(s, "个半", ".5", -1)
+	s = strings.Replace(s, "個半", ".5", -1)
+	s = strings.Replace(s, "半个", "半", -1)
+	s = strings.Replace(s, "半個", "半", -1)
+	s = strings.Replace(s, "个", "", -1)
+	s = strings.Replace(s, "個", "", -1)
+	s = halfAfterUnitRegexp.ReplaceAllString(s, "$1.5$2")
+	s = halfRegexp.ReplaceAllString(s, "${1}0.5")
+	return s
+}
+
+var (
+	chineseNumeralDigits = map[rune]int{
+		'一': 1, '二': 2, '两': 2, '兩': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+	}
+	chineseNumberUnits = map[rune]int{'十': 10, '百': 100, '千': 1000}
+)
+
+// parseChineseNumber parses Chinese numerals less than 10000, e.g. 二十五 is 25, 两 is 2
+func parseChineseNumber(s string) int {
+	total, n := 0, 0
+	for _, c := range s {
+		switch c {
+		case '零', '〇':
+			n = 0
+		case '十', '百', '千':
+			if n == 0 {
+				n = 1
+			}
+			total += n * chineseNumberUnits[c]
+			n = 0
+		default:
+			n = chineseNumeralDigits[c]
+		}
+	}
+	return total + n
+}
@@ -0,0 +1,127 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatter_DurationText(t *testing.T) {
+	d := 2*time.Hour + 30*time.Minute
+	en := timex.NewFormatter("en", nil)
+	zh := timex.NewFormatter("zh-Hans", nil)
+	assert.Equal(t, "2 hours 30 minutes", en.DurationText(d, nil))
+	assert.Equal(t, "2h 30m", en.DurationText(d, &timex.DurationOptions{Style: timex.ShortDuration}))
+	assert.Equal(t, "2h30m", en.DurationText(d, &timex.DurationOptions{Style: timex.NarrowDuration}))
+	assert.Equal(t, "2小时30分钟", zh.DurationText(d, nil))
+	assert.Equal(t, "2时30分", zh.DurationText(d, &timex.DurationOptions{Style: timex.NarrowDuration}))
+	assert.Equal(t, "2시간 30분", timex.NewFormatter("ko", nil).DurationText(d, nil))
+
+	assert.Equal(t, "1 hour 1 second", en.DurationText(time.Hour+time.Second, nil))
+	assert.Equal(t, "0 seconds", en.DurationText(0, nil))
+	assert.Equal(t, "-5 minutes", en.DurationText(-5*time.Minute, nil))
+	assert.Equal(t, "3 days 4 hours", en.DurationText(3*timex.Day+4*time.Hour, nil))
+
+	d = timex.Day + 2*time.Hour + 40*time.Minute + 10*time.Second
+	assert.Equal(t, "1 day 2 hours", en.DurationText(d, &timex.DurationOptions{MaxUnits: 2}))
+	assert.Equal(t, "1 day 3 hours", en.DurationText(d, &timex.DurationOptions{MaxUnits: 2, Round: true}))
+	assert.Equal(t, "1 day 2 hours 40 minutes", en.DurationText(d, &timex.DurationOptions{MinUnit: time.Minute}))
+	assert.Equal(t, "1 hour", en.DurationText(59*time.Minute+40*time.Second, &timex.DurationOptions{MaxUnits: 1, Round: true}))
+	assert.Equal(t, "0 minutes", en.DurationText(20*time.Second, &timex.DurationOptions{MinUnit: time.Minute}))
+	assert.Equal(t, "1 second 500 milliseconds", en.DurationText(1500*time.Millisecond, &timex.DurationOptions{MinUnit: time.Millisecond}))
+}
+
+func TestFormatter_PeriodText(t *testing.T) {
+	en := timex.NewFormatter("en", nil)
+	p := timex.NewPeriod(1, 2, 3, 4*time.Hour)
+	assert.Equal(t, "1 year 2 months 3 days 4 hours", en.PeriodText(p, nil))
+	assert.Equal(t, "1y 2mo", en.PeriodText(p, &timex.DurationOptions{Style: timex.ShortDuration, MaxUnits: 2}))
+	assert.Equal(t, "1年2个月3天4小时", timex.NewFormatter("zh-Hans", nil).PeriodText(p, nil))
+	assert.Equal(t, "-1 day", en.PeriodText(timex.NewPeriod(0, 0, -1, 0), nil))
+
+	// fields with different signs have no ISO 8601 form
+	assert.Panics(t, func() {
+		timex.NewPeriod(0, 0, 1, -time.Hour)
+	})
+	assert.Panics(t, func() {
+		timex.NewPeriod(1, 0, -3, 0)
+	})
+	mixed := &timex.Period{Days: 1, Clock: -time.Hour}
+	assert.Panics(t, func() {
+		en.PeriodText(mixed, nil)
+	})
+	assert.Panics(t, func() {
+		_ = mixed.String()
+	})
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		text string
+		want *timex.Period
+		iso  string
+	}{
+		{"P1Y2M10DT2H30M", timex.NewPeriod(1, 2, 10, 2*time.Hour+30*time.Minute), "P1Y2M10DT2H30M"},
+		{"P2W", timex.NewPeriod(0, 0, 14, 0), "P14D"},
+		{"PT1.5H", timex.NewPeriod(0, 0, 0, 90*time.Minute), "PT1H30M"},
+		{"PT0,5S", timex.NewPeriod(0, 0, 0, 500*time.Millisecond), "PT0.5S"},
+		{"-P1D", timex.NewPeriod(0, 0, -1, 0), "-P1D"},
+		{"PT0S", timex.NewPeriod(0, 0, 0, 0), "PT0S"},
+	}
+	for _, test := range tests {
+		p, err := timex.ParsePeriod(test.text)
+		require.NoError(t, err, test.text)
+		assert.Equal(t, test.want, p, test.text)
+		assert.Equal(t, test.iso, p.String(), test.text)
+	}
+	for _, s := range []string{"", "P", "PT", "P1DT", "1D", "P1H"} {
+		_, err := timex.ParsePeriod(s)
+		assert.Error(t, err, s)
+	}
+
+	tm := time.Date(2026, 1, 31, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC), timex.NewPeriod(0, 1, 1, 2*time.Hour).AddTo(tm))
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		text string
+		want time.Duration
+	}{
+		{"1h30m", 90 * time.Minute},
+		{"1.5 hours", 90 * time.Minute},
+		{"90 min", 90 * time.Minute},
+		{"2 days 3 hours", 2*timex.Day + 3*time.Hour},
+		{"1 week", timex.Week},
+		{"1d2h", timex.Day + 2*time.Hour},
+		{"an hour", time.Hour},
+		{"half an hour", 30 * time.Minute},
+		{"2 hours, 15 minutes", 2*time.Hour + 15*time.Minute},
+		{"1 hour and 5 minutes", time.Hour + 5*time.Minute},
+		{"-10 minutes", -10 * time.Minute},
+		{"一个半小时", 90 * time.Minute},
+		{"半小时", 30 * time.Minute},
+		{"两个小时", 2 * time.Hour},
+		{"一小时半", 90 * time.Minute},
+		{"2小时30分钟", 2*time.Hour + 30*time.Minute},
+		{"十五分钟", 15 * time.Minute},
+		{"三天", 3 * timex.Day},
+		{"1時間30分", 90 * time.Minute},
+		{"2시간 30분", 2*time.Hour + 30*time.Minute},
+		{"PT1H30M", 90 * time.Minute},
+		{"P2D", 2 * timex.Day},
+		{"P1W", timex.Week},
+	}
+	for _, test := range tests {
+		d, err := timex.ParseDuration(test.text)
+		require.NoError(t, err, test.text)
+		assert.Equal(t, test.want, d, test.text)
+	}
+	for _, s := range []string{"", "-", "- ", "P1M", "1 year", "hours", "5 parsecs"} {
+		_, err := timex.ParseDuration(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,237 @@
+package timex
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Era is a period whose years are counted from 1, beginning on date Begin.
+// Names are keyed by language tag, e.g. en, zh-Hans, zh-Hant, ja, th.
+type Era struct {
+	Begin *Date             `json:"begin"`
+	Names map[string]string `json:"names"`
+}
+
+// Name returns era name in current language
+func (e *Era) Name() string {
+	return localize(e.Names)
+}
+
+func (e *Era) String() string {
+	return fmt.Sprintf("%s %s", e.Begin, e.Names["en"])
+}
+
+// GregorianYear returns Gregorian year of the era year
+func (e *Era) GregorianYear(year int) int {
+	return e.Begin.year + year - 1
+}
+
+// EraCalendar numbers years by eras, e.g. 令和8年, 民國115年, พ.ศ. 2569
+type EraCalendar struct {
+	id string
+
+	mu   sync.RWMutex
+	eras []*Era
+}
+
+// NewEraCalendar creates an era calendar, more eras can be added later with AddEra
+func NewEraCalendar(id string, eras ...*Era) *EraCalendar {
+	c := &EraCalendar{id: id}
+	for _, e := range eras {
+		c.AddEra(e)
+	}
+	return c
+}
+
+var (
+	// Japanese is the calendar of Japanese imperial eras since Meiji
+	Japanese = NewEraCalendar("japanese",
+		newEra(1868, 10, 23, "Meiji", "明治", "明治"),
+		newEra(1912, 7, 30, "Taisho", "大正", "大正"),
+		newEra(1926, 12, 25, "Showa", "昭和", "昭和"),
+		newEra(1989, 1, 8, "Heisei", "平成", "平成"),
+		newEra(2019, 5, 1, "Reiwa", "令和", "令和"),
+	)
+
+	// ROC is the Minguo calendar used in Taiwan, counting years from 1912
+	ROC = NewEraCalendar("roc", &Era{
+		Begin: NewDate(1912, 1, 1),
+		Names: map[string]string{"en": "Minguo", "zh-Hans": "民国", "zh-Hant": "民國"},
+	})
+
+	// ThaiBuddhist is the Buddhist Era used in Thailand, which is 543 years ahead of Gregorian years
+	ThaiBuddhist = NewEraCalendar("buddhist", &Era{
+		Begin: NewDate(-542, 1, 1),
+		Names: map[string]string{"en": "BE", "zh-Hans": "佛历", "zh-Hant": "佛曆", "th": "พ.ศ."},
+	})
+)
+
+func newEra(year, month, day int, en, ja, zh string) *Era {
+	return &Era{
+		Begin: NewDate(year, month, day),
+		Names: map[string]string{"en": en, "ja": ja, "zh-Hans": zh, "zh-Hant": zh},
+	}
+}
+
+func (c *EraCalendar) ID() string {
+	return c.id
+}
+
+// AddEra adds an era, e.g. a new Japanese era announced after this package is released
+func (c *EraCalendar) AddEra(e *Era) {
+	if e == nil || e.Begin == nil || e.Names["en"] == "" {
+		panic("timex: era must have begin date and English name")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l := append([]*Era{e}, c.eras...)
+	sort.SliceStable(l, func(i, j int) bool {
+		return daysBetween(l[i].Begin, l[j].Begin) > 0
+	})
+	c.eras = l
+}
+
+// Eras returns eras in chronological order
+func (c *EraCalendar) Eras() []*Era {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]*Era(nil), c.eras...)
+}
+
+// Era returns the era of d and the year in the era. Era is nil if d is before the first era.
+func (c *EraCalendar) Era(d *Date) (*Era, int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for i := len(c.eras) - 1; i >= 0; i-- {
+		e := c.eras[i]
+		if daysBetween(e.Begin, d) >= 0 {
+			return e, d.year - e.Begin.year + 1
+		}
+	}
+	return nil, 0
+}
+
+func (c *EraCalendar) next(e *Era) *Era {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for i, v := range c.eras {
+		if v == e && i+1 < len(c.eras) {
+			return c.eras[i+1]
+		}
+	}
+	return nil
+}
+
+// YearText returns era year of d in current language, e.g. 令和8年, Reiwa 8, พ.ศ. 2569. It's empty if d is before the first era.
+func (c *EraCalendar) YearText(d *Date) string {
+	e, y := c.Era(d)
+	if e == nil {
+		return ""
+	}
+	l := CurrentLocale()
+	year := strconv.Itoa(y)
+	// CJK locales write first year as 元, e.g. 令和元年
+	if w, ok := l.word("era_first_year"); ok && y == 1 {
+		year = w
+	}
+	return expandPattern(l.Pattern("era_year"), e.Name(), year)
+}
+
+// EraText returns text like PrettyText with era year, e.g. 令和8年10月19日, Oct 19, Reiwa 8.
+// It returns PrettyText if d is before the first era.
+func (d *Date) EraText(c *EraCalendar) string {
+	y := c.YearText(d)
+	if y == "" {
+		return d.PrettyText()
+	}
+	l := CurrentLocale()
+	return expandPattern(l.Pattern("era_date"), y, l.Format(d.t, l.Pattern("month_day")))
+}
+
+var eraYearRegexp = regexp.MustCompile(`^\s*(\d+|元)\s*年?`)
+
+// parseEraYear finds an era name in s and returns Gregorian year, the era and start and end index of era year text
+func (c *EraCalendar) parseEraYear(s string) (year int, era *Era, begin, end int, err error) {
+	for _, e := range c.Eras() {
+		for _, name := range e.Names {
+			i := eraNameIndex(s, name)
+			if i < 0 {
+				continue
+			}
+			m := eraYearRegexp.FindStringSubmatchIndex(s[i+len(name):])
+			if m == nil {
+				continue
+			}
+			y := 1
+			if v := s[i+len(name)+m[2] : i+len(name)+m[3]]; v != "元" {
+				y, _ = strconv.Atoi(v)
+			}
+			// prefer the longest name, e.g. 民國 over 民
+			if era == nil || end-begin < len(name)+m[1] {
+				year, era, begin, end = e.GregorianYear(y), e, i, i+len(name)+m[1]
+			}
+		}
+	}
+	if era == nil {
+		return 0, nil, 0, 0, fmt.Errorf("timex: no %s era year in %q", c.id, s)
+	}
+	return year, era, begin, end, nil
+}
+
+// eraNameIndex returns index of the first name in s, or -1. Latin names must begin a word, e.g. BE is not in MAYBE,
+// while CJK names can follow other words because CJK text has no spaces.
+func eraNameIndex(s, name string) int {
+	r, _ := utf8.DecodeRuneInString(name)
+	for off := 0; off < len(s); {
+		i := strings.Index(s[off:], name)
+		if i < 0 {
+			return -1
+		}
+		i += off
+		if !unicode.Is(unicode.Latin, r) || i == 0 {
+			return i
+		}
+		if p, _ := utf8.DecodeLastRuneInString(s[:i]); !unicode.IsLetter(p) && !unicode.IsDigit(p) {
+			return i
+		}
+		off = i + len(name)
+	}
+	return -1
+}
+
+// ParseYear parses era year text in any language and returns Gregorian year, e.g. 令和元年 is 2019, พ.ศ. 2569 is 2026
+func (c *EraCalendar) ParseYear(s string) (int, error) {
+	y, _, _, _, err := c.parseEraYear(s)
+	return y, err
+}
+
+// ParseDate parses date text with era year, e.g. 令和8年10月19日, 民國115年10月19日, Oct 19, Reiwa 8.
+// The date must be in the era.
+func (c *EraCalendar) ParseDate(s string, opts *ParseOptions) (*Date, error) {
+	y, e, begin, end, err := c.parseEraYear(s)
+	if err != nil {
+		return nil, err
+	}
+	text := s[:begin] + strconv.Itoa(y)
+	if strings.HasSuffix(s[begin:end], "年") {
+		text += "年"
+	}
+	text += s[end:]
+	d, _, err := ParseDate(strings.TrimSpace(text), opts)
+	if err != nil {
+		return nil, fmt.Errorf("parse %q: %w", text, err)
+	}
+	if daysBetween(e.Begin, d) < 0 {
+		return nil, fmt.Errorf("timex: %s is before era %s", d, e.Names["en"])
+	}
+	if next := c.next(e); next != nil && daysBetween(next.Begin, d) >= 0 {
+		return nil, fmt.Errorf("timex: %s is after era %s", d, e.Names["en"])
+	}
+	return d, nil
+}
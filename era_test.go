@@ -0,0 +1,99 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEraCalendar_Era(t *testing.T) {
+	e, y := timex.Japanese.Era(timex.NewDate(2019, 4, 30))
+	assert.Equal(t, "Heisei", e.Names["en"])
+	assert.Equal(t, 31, y)
+	e, y = timex.Japanese.Era(timex.NewDate(2019, 5, 1))
+	assert.Equal(t, "Reiwa", e.Names["en"])
+	assert.Equal(t, 1, y)
+	e, _ = timex.Japanese.Era(timex.NewDate(1868, 1, 1))
+	assert.Nil(t, e)
+
+	d := timex.NewDate(2026, 10, 19)
+	assert.Equal(t, "Reiwa 8", timex.Japanese.YearText(d))
+	assert.Equal(t, "Minguo 115", timex.ROC.YearText(d))
+	assert.Equal(t, "BE 2569", timex.ThaiBuddhist.YearText(d))
+	assert.Equal(t, "Oct 19, Reiwa 8", d.EraText(timex.Japanese))
+	assert.Equal(t, timex.NewDate(1911, 5, 1).PrettyText(), timex.NewDate(1911, 5, 1).EraText(timex.ROC))
+}
+
+func TestEraCalendar_YearText_Locales(t *testing.T) {
+	defer timex.SetLang("en")
+	d := timex.NewDate(2026, 10, 19)
+
+	timex.SetLang("ja")
+	assert.Equal(t, "令和元年", timex.Japanese.YearText(timex.NewDate(2019, 5, 1)))
+	assert.Equal(t, "令和8年10月19日", d.EraText(timex.Japanese))
+
+	timex.SetLang("zh-Hant")
+	assert.Equal(t, "民國115年", timex.ROC.YearText(d))
+	assert.Equal(t, "民國元年", timex.ROC.YearText(timex.NewDate(1912, 1, 1)))
+	assert.Equal(t, "民國115年10月19日", d.EraText(timex.ROC))
+
+	timex.SetLang("th")
+	assert.Equal(t, "พ.ศ. 2569", timex.ThaiBuddhist.YearText(d))
+	assert.Equal(t, "19 ต.ค. พ.ศ. 2569", d.EraText(timex.ThaiBuddhist))
+}
+
+func TestEraCalendar_Parse(t *testing.T) {
+	y, err := timex.Japanese.ParseYear("令和元年")
+	require.NoError(t, err)
+	assert.Equal(t, 2019, y)
+	y, err = timex.ROC.ParseYear("民國115年")
+	require.NoError(t, err)
+	assert.Equal(t, 2026, y)
+	y, err = timex.ThaiBuddhist.ParseYear("พ.ศ. 2569")
+	require.NoError(t, err)
+	assert.Equal(t, 2026, y)
+	_, err = timex.Japanese.ParseYear("2026年")
+	assert.Error(t, err)
+	// Latin names must begin a word
+	_, err = timex.ThaiBuddhist.ParseYear("MAYBE 2569")
+	assert.Error(t, err)
+	y, err = timex.ThaiBuddhist.ParseYear("MAYBE BE 2569")
+	require.NoError(t, err)
+	assert.Equal(t, 2026, y)
+	_, err = timex.Japanese.ParseYear("PreReiwa 8")
+	assert.Error(t, err)
+
+	d, err := timex.Japanese.ParseDate("令和8年10月19日", nil)
+	require.NoError(t, err)
+	assert.True(t, d.Equals(timex.NewDate(2026, 10, 19)))
+	d, err = timex.Japanese.ParseDate("Oct 19, Reiwa 8", nil)
+	require.NoError(t, err)
+	assert.True(t, d.Equals(timex.NewDate(2026, 10, 19)))
+	d, err = timex.ROC.ParseDate("民国115年10月19日", nil)
+	require.NoError(t, err)
+	assert.True(t, d.Equals(timex.NewDate(2026, 10, 19)))
+	// Heisei ended on 2019-04-30
+	_, err = timex.Japanese.ParseDate("平成31年5月1日", nil)
+	assert.Error(t, err)
+	_, err = timex.Japanese.ParseDate("令和元年4月30日", nil)
+	assert.Error(t, err)
+}
+
+func TestEraCalendar_AddEra(t *testing.T) {
+	c := timex.NewEraCalendar("test", &timex.Era{
+		Begin: timex.NewDate(2000, 1, 1),
+		Names: map[string]string{"en": "Alpha"},
+	})
+	c.AddEra(&timex.Era{
+		Begin: timex.NewDate(2020, 7, 1),
+		Names: map[string]string{"en": "Beta"},
+	})
+	require.Equal(t, 2, len(c.Eras()))
+	assert.Equal(t, "Alpha 21", c.YearText(timex.NewDate(2020, 6, 30)))
+	assert.Equal(t, "Beta 7", c.YearText(timex.NewDate(2026, 10, 19)))
+	assert.Panics(t, func() {
+		c.AddEra(&timex.Era{Begin: timex.NewDate(2030, 1, 1)})
+	})
+}
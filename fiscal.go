@@ -0,0 +1,256 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// WeekPattern defines how a fiscal quarter is split into periods
+type WeekPattern int
+
+const (
+	// MonthPattern: periods follow calendar months
+	MonthPattern WeekPattern = iota
+	Pattern445
+	Pattern454
+	Pattern544
+)
+
+var weekPatterns = map[WeekPattern][3]int{
+	Pattern445: {4, 4, 5},
+	Pattern454: {4, 5, 4},
+	Pattern544: {5, 4, 4},
+}
+
+func (p WeekPattern) IsValid() bool {
+	return p == MonthPattern || weekPatterns[p] != [3]int{}
+}
+
+func (p WeekPattern) String() string {
+	switch p {
+	case MonthPattern:
+		return "month"
+	case Pattern445:
+		return "4-4-5"
+	case Pattern454:
+		return "4-5-4"
+	case Pattern544:
+		return "5-4-4"
+	default:
+		return fmt.Sprint(int(p))
+	}
+}
+
+// YearEndRule decides the last day of a 52/53-week fiscal year
+type YearEndRule int
+
+const (
+	// LastWeekday: year ends on the last given weekday of the end month
+	LastWeekday YearEndRule = iota
+	// NearestWeekday: year ends on the given weekday nearest to the last day of the end month
+	NearestWeekday
+)
+
+// FiscalCalendar maps dates to fiscal year, quarter, period and week.
+// Month based calendars are created by NewFiscalCalendar, 52/53-week retail calendars by NewRetailCalendar.
+type FiscalCalendar struct {
+	pattern    WeekPattern
+	endMonth   int
+	endWeekday int
+	endRule    YearEndRule
+
+	// period which gets the extra week in 53-week years
+	leapWeekPeriod   int
+	namedByStartYear bool
+}
+
+// NewFiscalCalendar creates a fiscal calendar whose year starts on the first day of startMonth
+func NewFiscalCalendar(startMonth int) *FiscalCalendar {
+	if startMonth < 1 || startMonth > 12 {
+		panic(fmt.Sprintf("timex: invalid start month %d", startMonth))
+	}
+	endMonth := startMonth - 1
+	if endMonth == 0 {
+		endMonth = 12
+	}
+	return &FiscalCalendar{
+		pattern:        MonthPattern,
+		endMonth:       endMonth,
+		leapWeekPeriod: 12,
+	}
+}
+
+// NewRetailCalendar creates a 52/53-week calendar, e.g. NewRetailCalendar(Pattern445, 1, 6, LastWeekday)
+// ends on the last Saturday of January
+func NewRetailCalendar(pattern WeekPattern, endMonth, endWeekday int, rule YearEndRule) *FiscalCalendar {
+	if pattern == MonthPattern || !pattern.IsValid() {
+		panic(fmt.Sprintf("timex: invalid week pattern %v", pattern))
+	}
+	if endMonth < 1 || endMonth > 12 {
+		panic(fmt.Sprintf("timex: invalid end month %d", endMonth))
+	}
+	if endWeekday < 0 || endWeekday > 6 {
+		panic(fmt.Sprintf("timex: invalid end weekday %d", endWeekday))
+	}
+	return &FiscalCalendar{
+		pattern:        pattern,
+		endMonth:       endMonth,
+		endWeekday:     endWeekday,
+		endRule:        rule,
+		leapWeekPeriod: 12,
+	}
+}
+
+// SetNamedByStartYear names fiscal years by the calendar year they begin in. By default fiscal years are named by the year they end in.
+func (c *FiscalCalendar) SetNamedByStartYear(b bool) {
+	c.namedByStartYear = b
+}
+
+// SetLeapWeekPeriod sets the period [1, 12] which gets the 53rd week. Default is 12.
+func (c *FiscalCalendar) SetLeapWeekPeriod(p int) {
+	if p < 1 || p > 12 {
+		panic(fmt.Sprintf("timex: invalid period %d", p))
+	}
+	c.leapWeekPeriod = p
+}
+
+func (c *FiscalCalendar) Pattern() WeekPattern {
+	return c.pattern
+}
+
+// lastDay returns the last day of the fiscal year which ends in calendar year y
+func (c *FiscalCalendar) lastDay(y int) *Date {
+	last := NewDate(y, c.endMonth+1, 0)
+	if c.pattern == MonthPattern {
+		return last
+	}
+	switch c.endRule {
+	case NearestWeekday:
+		diff := (c.endWeekday - last.weekday + 7) % 7
+		if diff <= 3 {
+			return last.Add(0, 0, diff)
+		}
+		return last.Add(0, 0, diff-7)
+	default:
+		return last.Add(0, 0, -((last.weekday - c.endWeekday + 7) % 7))
+	}
+}
+
+// endYear converts fiscal year name into the calendar year in which it ends
+func (c *FiscalCalendar) endYear(year int) int {
+	if !c.namedByStartYear {
+		return year
+	}
+	if c.lastDay(year-1).Add(0, 0, 1).year == year {
+		return year
+	}
+	return year + 1
+}
+
+func (c *FiscalCalendar) name(endYear int) int {
+	if !c.namedByStartYear {
+		return endYear
+	}
+	return c.lastDay(endYear-1).Add(0, 0, 1).year
+}
+
+func (c *FiscalCalendar) firstDay(endYear int) *Date {
+	return c.lastDay(endYear-1).Add(0, 0, 1)
+}
+
+// YearRange returns the range of fiscal year
+func (c *FiscalCalendar) YearRange(year int) *Range {
+	y := c.endYear(year)
+	return NewRange(c.firstDay(y).Begin(), c.lastDay(y).Add(0, 0, 1).Begin())
+}
+
+// NumOfWeeks returns 52 or 53 for retail calendars. Month based calendars always return 53 as the last week is partial.
+func (c *FiscalCalendar) NumOfWeeks(year int) int {
+	return c.numOfWeeks(c.endYear(year))
+}
+
+func (c *FiscalCalendar) numOfWeeks(endYear int) int {
+	days := daysBetween(c.firstDay(endYear), c.lastDay(endYear)) + 1
+	return (days + 6) / 7
+}
+
+// periodBegin returns first day of period p [1, 13], p=13 means the first day of next fiscal year
+func (c *FiscalCalendar) periodBegin(endYear, p int) *Date {
+	first := c.firstDay(endYear)
+	if c.pattern == MonthPattern {
+		return NewDate(first.year, first.month+p-1, 1)
+	}
+	weeks := 0
+	pattern := weekPatterns[c.pattern]
+	for i := 1; i < p; i++ {
+		weeks += pattern[(i-1)%3]
+		if i == c.leapWeekPeriod && c.numOfWeeks(endYear) == 53 {
+			weeks++
+		}
+	}
+	return first.Add(0, 0, weeks*7)
+}
+
+// PeriodRange returns the range of period [1, 12] in fiscal year
+func (c *FiscalCalendar) PeriodRange(year, period int) *Range {
+	if period < 1 || period > 12 {
+		panic(fmt.Sprintf("timex: invalid period %d", period))
+	}
+	y := c.endYear(year)
+	return NewRange(c.periodBegin(y, period).Begin(), c.periodBegin(y, period+1).Begin())
+}
+
+// QuarterRange returns the range of quarter [1, 4] in fiscal year
+func (c *FiscalCalendar) QuarterRange(year, quarter int) *Range {
+	if quarter < 1 || quarter > 4 {
+		panic(fmt.Sprintf("timex: invalid quarter %d", quarter))
+	}
+	y := c.endYear(year)
+	return NewRange(c.periodBegin(y, quarter*3-2).Begin(), c.periodBegin(y, quarter*3+1).Begin())
+}
+
+// FiscalDate is a date expressed in a fiscal calendar
+type FiscalDate struct {
+	Year    int `json:"year"`
+	Quarter int `json:"quarter"`
+	Period  int `json:"period"`
+	Week    int `json:"week"`
+	// day of fiscal year, [1, 371]
+	Day int `json:"day"`
+}
+
+func (f *FiscalDate) String() string {
+	return fmt.Sprintf("FY%d Q%d P%d W%d", f.Year, f.Quarter, f.Period, f.Week)
+}
+
+// FiscalDate returns fiscal year, quarter, period and week of d
+func (c *FiscalCalendar) FiscalDate(d *Date) *FiscalDate {
+	y := d.year
+	if daysBetween(c.lastDay(y), d) > 0 {
+		y++
+	} else if daysBetween(c.lastDay(y-1), d) <= 0 {
+		y--
+	}
+	day := daysBetween(c.firstDay(y), d)
+	period := 12
+	for p := 2; p <= 12; p++ {
+		if daysBetween(c.periodBegin(y, p), d) < 0 {
+			period = p - 1
+			break
+		}
+	}
+	return &FiscalDate{
+		Year:    c.name(y),
+		Quarter: (period-1)/3 + 1,
+		Period:  period,
+		Week:    day/7 + 1,
+		Day:     day + 1,
+	}
+}
+
+// daysBetween returns number of days from begin to end, ignoring time zone offset changes
+func daysBetween(begin, end *Date) int {
+	b := time.Date(begin.year, time.Month(begin.month), begin.day, 0, 0, 0, 0, time.UTC)
+	e := time.Date(end.year, time.Month(end.month), end.day, 0, 0, 0, 0, time.UTC)
+	return int(e.Sub(b) / Day)
+}
@@ -0,0 +1,64 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiscalCalendar_MonthBased(t *testing.T) {
+	c := timex.NewFiscalCalendar(4)
+	fd := c.FiscalDate(timex.NewDate(2026, 10, 17))
+	assert.Equal(t, 2027, fd.Year)
+	assert.Equal(t, 3, fd.Quarter)
+	assert.Equal(t, 7, fd.Period)
+
+	r := c.YearRange(2027)
+	assert.True(t, r.FirstDay().Equals(timex.NewDate(2026, 4, 1)))
+	assert.True(t, r.LastDay().Equals(timex.NewDate(2027, 3, 31)))
+
+	c.SetNamedByStartYear(true)
+	assert.Equal(t, 2026, c.FiscalDate(timex.NewDate(2027, 3, 31)).Year)
+	r = c.QuarterRange(2026, 4)
+	assert.True(t, r.FirstDay().Equals(timex.NewDate(2027, 1, 1)))
+	assert.True(t, r.LastDay().Equals(timex.NewDate(2027, 3, 31)))
+}
+
+func TestFiscalCalendar_Retail(t *testing.T) {
+	// NRF calendar: ends on the Saturday nearest to Jan 31, named by start year
+	c := timex.NewRetailCalendar(timex.Pattern454, 1, 6, timex.NearestWeekday)
+	c.SetNamedByStartYear(true)
+
+	r := c.YearRange(2023)
+	assert.True(t, r.FirstDay().Equals(timex.NewDate(2023, 1, 29)))
+	assert.True(t, r.LastDay().Equals(timex.NewDate(2024, 2, 3)))
+	assert.Equal(t, 53, c.NumOfWeeks(2023))
+	assert.Equal(t, 52, c.NumOfWeeks(2024))
+
+	p := c.PeriodRange(2023, 12)
+	assert.Equal(t, 5, len(p.Dates())/7)
+	p = c.PeriodRange(2023, 2)
+	assert.True(t, p.FirstDay().Equals(timex.NewDate(2023, 2, 26)))
+
+	fd := c.FiscalDate(timex.NewDate(2024, 2, 3))
+	assert.Equal(t, 2023, fd.Year)
+	assert.Equal(t, 12, fd.Period)
+	assert.Equal(t, 4, fd.Quarter)
+	assert.Equal(t, 53, fd.Week)
+
+	fd = c.FiscalDate(timex.NewDate(2024, 2, 4))
+	assert.Equal(t, 2024, fd.Year)
+	assert.Equal(t, 1, fd.Period)
+	assert.Equal(t, 1, fd.Week)
+}
+
+func TestFiscalCalendar_LastWeekday(t *testing.T) {
+	c := timex.NewRetailCalendar(timex.Pattern445, 1, 6, timex.LastWeekday)
+	r := c.YearRange(2026)
+	assert.True(t, r.FirstDay().Equals(timex.NewDate(2025, 1, 26)))
+	assert.True(t, r.LastDay().Equals(timex.NewDate(2026, 1, 31)))
+	assert.Equal(t, 53, c.NumOfWeeks(2026))
+	q := c.QuarterRange(2026, 1)
+	assert.Equal(t, 13*7, len(q.Dates()))
+}
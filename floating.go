@@ -0,0 +1,333 @@
+package timex
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	_ encoding.TextMarshaler   = (*LocalDateTime)(nil)
+	_ encoding.TextUnmarshaler = (*LocalDateTime)(nil)
+	_ driver.Valuer            = (*LocalDateTime)(nil)
+	_ sql.Scanner              = (*LocalDateTime)(nil)
+)
+
+const (
+	// LocalDateTimeLayout is ISO 8601 date time without offset, used by MarshalText
+	LocalDateTimeLayout = "2006-01-02T15:04:05.999999999"
+	// ICalendarLocalDateTimeLayout is iCalendar floating DATE-TIME, e.g. 20261019T080000
+	ICalendarLocalDateTimeLayout = "20060102T150405"
+
+	sqlLocalDateTimeLayout = "2006-01-02 15:04:05.999999999"
+)
+
+var localDateTimeLayouts = []string{
+	LocalDateTimeLayout,
+	sqlLocalDateTimeLayout,
+	ICalendarLocalDateTimeLayout,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+}
+
+// LocalDateTime is a date and wall clock time without time zone, e.g. iCalendar floating time.
+// It resolves to the same wall clock time in any time zone.
+type LocalDateTime struct {
+	// t holds wall clock fields in UTC, which has no DST
+	t time.Time
+}
+
+func NewLocalDateTime(year, month, day, hour, minute, second int) *LocalDateTime {
+	return &LocalDateTime{t: time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)}
+}
+
+// LocalDateTimeWithTime returns wall clock time of t in t's time zone
+func LocalDateTimeWithTime(t time.Time) *LocalDateTime {
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	return &LocalDateTime{t: time.Date(y, mo, d, h, mi, s, t.Nanosecond(), time.UTC)}
+}
+
+// ParseLocalDateTime parses ISO 8601 or iCalendar date time without offset, e.g. 2026-10-19T08:00:00, 20261019T080000
+func ParseLocalDateTime(s string) (*LocalDateTime, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range localDateTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &LocalDateTime{t: t}, nil
+		}
+	}
+	return nil, &ParseError{Text: s}
+}
+
+func (l *LocalDateTime) Year() int {
+	return l.t.Year()
+}
+
+func (l *LocalDateTime) Month() int {
+	return int(l.t.Month())
+}
+
+func (l *LocalDateTime) Day() int {
+	return l.t.Day()
+}
+
+func (l *LocalDateTime) Hour() int {
+	return l.t.Hour()
+}
+
+func (l *LocalDateTime) Minute() int {
+	return l.t.Minute()
+}
+
+func (l *LocalDateTime) Second() int {
+	return l.t.Second()
+}
+
+func (l *LocalDateTime) Nanosecond() int {
+	return l.t.Nanosecond()
+}
+
+func (l *LocalDateTime) Weekday() int {
+	return int(l.t.Weekday())
+}
+
+// Date returns the date in time.Local
+func (l *LocalDateTime) Date() *Date {
+	return NewDate(l.Year(), l.Month(), l.Day())
+}
+
+// DayTime returns wall clock offset from midnight
+func (l *LocalDateTime) DayTime() time.Duration {
+	return GetDayTime(l.t)
+}
+
+// In resolves l in time zone loc. Nonexistent times in DST gaps are shifted forward, ambiguous times use the earlier instant.
+func (l *LocalDateTime) In(loc *time.Location) time.Time {
+	t, _ := l.InWithPolicy(loc, nil)
+	return t
+}
+
+// InWithPolicy resolves l in time zone loc with DST policy p
+func (l *LocalDateTime) InWithPolicy(loc *time.Location, p *DSTPolicy) (time.Time, error) {
+	return WallClock(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), l.Nanosecond(), loc, p)
+}
+
+// Add adds wall clock duration d, which ignores DST of any time zone
+func (l *LocalDateTime) Add(d time.Duration) *LocalDateTime {
+	return &LocalDateTime{t: l.t.Add(d)}
+}
+
+func (l *LocalDateTime) AddDate(years, months, days int) *LocalDateTime {
+	return &LocalDateTime{t: l.t.AddDate(years, months, days)}
+}
+
+// Sub returns wall clock duration l-u
+func (l *LocalDateTime) Sub(u *LocalDateTime) time.Duration {
+	return l.t.Sub(u.t)
+}
+
+func (l *LocalDateTime) Equals(u *LocalDateTime) bool {
+	return l.t.Equal(u.t)
+}
+
+func (l *LocalDateTime) Before(u *LocalDateTime) bool {
+	return l.t.Before(u.t)
+}
+
+func (l *LocalDateTime) After(u *LocalDateTime) bool {
+	return l.t.After(u.t)
+}
+
+// Format formats wall clock time with layout defined in package time. Zone is always UTC and shouldn't be in the layout.
+func (l *LocalDateTime) Format(layout string) string {
+	return l.t.Format(layout)
+}
+
+func (l *LocalDateTime) String() string {
+	return l.Format(LocalDateTimeLayout)
+}
+
+// ICalendar returns iCalendar floating DATE-TIME, e.g. 20261019T080000
+func (l *LocalDateTime) ICalendar() string {
+	return l.Format(ICalendarLocalDateTimeLayout)
+}
+
+func (l *LocalDateTime) MarshalText() (text []byte, err error) {
+	return []byte(l.String()), nil
+}
+
+func (l *LocalDateTime) UnmarshalText(text []byte) error {
+	v, err := ParseLocalDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*l = *v
+	return nil
+}
+
+// Scan reads SQL timestamp without time zone. Drivers return it as time.Time in UTC or as text.
+func (l *LocalDateTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case time.Time:
+		*l = *LocalDateTimeWithTime(v)
+		return nil
+	case string:
+		return l.UnmarshalText([]byte(v))
+	case []byte:
+		return l.UnmarshalText(v)
+	default:
+		return fmt.Errorf("expect time.Time, string or []byte instead of %T", src)
+	}
+}
+
+// Value returns text without offset, so that drivers don't convert it between time zones
+func (l *LocalDateTime) Value() (driver.Value, error) {
+	if l == nil {
+		return nil, nil
+	}
+	return l.Format(sqlLocalDateTimeLayout), nil
+}
+
+var (
+	_ json.Marshaler   = (*FloatingRange)(nil)
+	_ json.Unmarshaler = (*FloatingRange)(nil)
+	_ driver.Valuer    = (*FloatingRange)(nil)
+	_ sql.Scanner      = (*FloatingRange)(nil)
+)
+
+// FloatingRange is [begin, end) of wall clock times, which resolves to a Range in a given time zone
+type FloatingRange struct {
+	begin LocalDateTime // inclusive
+	end   LocalDateTime // exclusive
+}
+
+func NewFloatingRange(begin, end *LocalDateTime) *FloatingRange {
+	if begin.After(end) {
+		panic("timex: expect begin <= end")
+	}
+	return &FloatingRange{
+		begin: *begin,
+		end:   *end,
+	}
+}
+
+func (r *FloatingRange) Begin() *LocalDateTime {
+	b := r.begin
+	return &b
+}
+
+func (r *FloatingRange) End() *LocalDateTime {
+	e := r.end
+	return &e
+}
+
+// Duration returns wall clock duration, the resolved Range may be an hour longer or shorter across DST transitions
+func (r *FloatingRange) Duration() time.Duration {
+	return r.end.Sub(&r.begin)
+}
+
+func (r *FloatingRange) Contains(l *LocalDateTime) bool {
+	return !r.begin.After(l) && l.Before(&r.end)
+}
+
+// In resolves r in time zone loc with the zero DST policy
+func (r *FloatingRange) In(loc *time.Location) *Range {
+	return NewRange(r.begin.In(loc), r.end.In(loc))
+}
+
+// InWithPolicy resolves r in time zone loc with DST policy p
+func (r *FloatingRange) InWithPolicy(loc *time.Location, p *DSTPolicy) (*Range, error) {
+	begin, err := r.begin.InWithPolicy(loc, p)
+	if err != nil {
+		return nil, fmt.Errorf("resolve begin: %w", err)
+	}
+	end, err := r.end.InWithPolicy(loc, p)
+	if err != nil {
+		return nil, fmt.Errorf("resolve end: %w", err)
+	}
+	return NewRange(begin, end), nil
+}
+
+// ICalendar returns DTSTART and DTEND properties of floating time, separated by CRLF
+func (r *FloatingRange) ICalendar() string {
+	return "DTSTART:" + r.begin.ICalendar() + "\r\nDTEND:" + r.end.ICalendar()
+}
+
+func (r *FloatingRange) String() string {
+	return fmt.Sprintf("[%s, %s)", &r.begin, &r.end)
+}
+
+type floatingRangeJSON struct {
+	Begin *LocalDateTime `json:"begin"`
+	End   *LocalDateTime `json:"end"`
+}
+
+func (r *FloatingRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&floatingRangeJSON{Begin: &r.begin, End: &r.end})
+}
+
+func (r *FloatingRange) UnmarshalJSON(b []byte) error {
+	var v floatingRangeJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	if v.Begin == nil || v.End == nil {
+		return fmt.Errorf("missing begin or end")
+	}
+	if v.Begin.After(v.End) {
+		return fmt.Errorf("begin %v is after end %v", v.Begin, v.End)
+	}
+	r.begin, r.end = *v.Begin, *v.End
+	return nil
+}
+
+// Scan reads composite or range text like Range.Scan, e.g. [2026-10-19 08:00:00, 2026-10-19 09:00:00]
+func (r *FloatingRange) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("expect string or []byte instead of %T", src)
+	}
+	if s == "" {
+		return nil
+	}
+	s = strings.Replace(s, `"`, "", -1)
+	if len(s) < 2 || !strings.ContainsAny(s[:1], "[(") || !strings.ContainsAny(s[len(s)-1:], "])") {
+		return fmt.Errorf("cannot parse %s", s)
+	}
+	fields := strings.Split(s[1:len(s)-1], ",")
+	if len(fields) != 2 {
+		return fmt.Errorf("parse composite fields %s", s)
+	}
+	begin, err := ParseLocalDateTime(fields[0])
+	if err != nil {
+		return fmt.Errorf("parse begin %s: %w", fields[0], err)
+	}
+	end, err := ParseLocalDateTime(fields[1])
+	if err != nil {
+		return fmt.Errorf("parse end %s: %w", fields[1], err)
+	}
+	if begin.After(end) {
+		return fmt.Errorf("begin %v is after end %v", begin, end)
+	}
+	r.begin, r.end = *begin, *end
+	return nil
+}
+
+// Value returns composite text in the notation of Range.Value, e.g. [2026-10-19 08:00:00, 2026-10-19 09:00:00]
+func (r *FloatingRange) Value() (driver.Value, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return fmt.Sprintf("[%s, %s]", r.begin.Format(sqlLocalDateTimeLayout), r.end.Format(sqlLocalDateTimeLayout)), nil
+}
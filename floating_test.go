@@ -0,0 +1,87 @@
+package timex_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDateTime(t *testing.T) {
+	l := timex.NewLocalDateTime(2026, 10, 19, 8, 0, 0)
+	tokyo := time.FixedZone("JST", 9*3600)
+	ny := loadLocation(t, "America/New_York")
+	assert.Equal(t, time.Date(2026, 10, 19, 8, 0, 0, 0, tokyo), l.In(tokyo))
+	assert.Equal(t, time.Date(2026, 10, 19, 8, 0, 0, 0, ny), l.In(ny))
+	assert.Equal(t, "2026-10-19T08:00:00", l.String())
+	assert.Equal(t, "20261019T080000", l.ICalendar())
+	assert.True(t, l.Date().Equals(timex.NewDate(2026, 10, 19)))
+	assert.Equal(t, 8*time.Hour, l.DayTime())
+
+	// 02:30 doesn't exist in New York on 2026-03-08
+	gap := timex.NewLocalDateTime(2026, 3, 8, 2, 30, 0)
+	assert.Equal(t, 3, gap.In(ny).Hour())
+	_, err := gap.InWithPolicy(ny, &timex.DSTPolicy{Gap: timex.GapError})
+	assert.Error(t, err)
+
+	for _, s := range []string{"2026-10-19T08:00:00", "20261019T080000", "2026-10-19 08:00", "2026-10-19T08:00"} {
+		v, err := timex.ParseLocalDateTime(s)
+		require.NoError(t, err, s)
+		assert.True(t, v.Equals(l), s)
+	}
+	_, err = timex.ParseLocalDateTime("2026-10-19T08:00:00Z")
+	assert.Error(t, err)
+
+	b, err := json.Marshal(l)
+	require.NoError(t, err)
+	assert.Equal(t, `"2026-10-19T08:00:00"`, string(b))
+	var v timex.LocalDateTime
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.True(t, v.Equals(l))
+
+	dv, err := l.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-19 08:00:00", dv)
+	require.NoError(t, v.Scan(time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)))
+	assert.True(t, v.Equals(l))
+	require.NoError(t, v.Scan([]byte("2026-10-19 08:00:00")))
+	assert.True(t, v.Equals(l))
+}
+
+func TestFloatingRange(t *testing.T) {
+	begin := timex.NewLocalDateTime(2026, 11, 1, 0, 0, 0)
+	r := timex.NewFloatingRange(begin, begin.AddDate(0, 0, 1))
+	assert.Equal(t, 24*time.Hour, r.Duration())
+	assert.True(t, r.Contains(begin.Add(time.Hour)))
+	assert.False(t, r.Contains(r.End()))
+
+	ny := loadLocation(t, "America/New_York")
+	rr := r.In(ny)
+	assert.Equal(t, 25*time.Hour, rr.Duration())
+	assert.True(t, rr.IsAllDay())
+	assert.Equal(t, 24*time.Hour, r.In(time.UTC).Duration())
+
+	assert.Equal(t, "DTSTART:20261101T000000\r\nDTEND:20261102T000000", r.ICalendar())
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.Equal(t, `{"begin":"2026-11-01T00:00:00","end":"2026-11-02T00:00:00"}`, string(b))
+	var v timex.FloatingRange
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.True(t, v.Begin().Equals(begin))
+	assert.Error(t, json.Unmarshal([]byte(`{"begin":"2026-11-02T00:00:00","end":"2026-11-01T00:00:00"}`), &v))
+
+	dv, err := r.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "[2026-11-01 00:00:00, 2026-11-02 00:00:00]", dv)
+	var s timex.FloatingRange
+	require.NoError(t, s.Scan(dv))
+	assert.Equal(t, r.String(), s.String())
+
+	assert.Panics(t, func() {
+		timex.NewFloatingRange(r.End(), r.Begin())
+	})
+}
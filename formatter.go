@@ -0,0 +1,205 @@
+package timex
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gopub/conv"
+)
+
+// HourCycle is the clock of time text
+type HourCycle int
+
+const (
+	// LocaleHourCycle uses the clock preferred by the locale, e.g. 1:00PM in en, 13:00 in some others
+	LocaleHourCycle HourCycle = iota
+	// HourCycle12 uses 12-hour clock with day periods, e.g. 1:00PM, 下午1:00
+	HourCycle12
+	// HourCycle24 uses 24-hour clock, e.g. 13:00
+	HourCycle24
+)
+
+// Formatter renders text with its own locale, time zone, hour cycle and first weekday,
+// so that concurrent requests can render text for different users.
+// Text methods of Date, Month, Time and Range use a default formatter whose locale is selected by SetLang.
+type Formatter struct {
+	// Locale is the locale selected by SetLang if nil
+	Locale *Locale
+
+	// Location converts times and ranges before formatting, which are formatted in their own time zones if nil
+	Location *time.Location
+
+	HourCycle HourCycle
+
+	// FirstWeekday is [0, 6], 0 is Sunday
+	FirstWeekday int
+}
+
+// NewFormatter creates a formatter with the locale which best matches tag, e.g. en-US, zh-Hant-TW, ja-JP
+func NewFormatter(tag string, loc *time.Location) *Formatter {
+	return &Formatter{
+		Locale:   GetLocale(tag),
+		Location: loc,
+	}
+}
+
+var defaultFormatter = &Formatter{}
+
+func (f *Formatter) locale() *Locale {
+	if f.Locale != nil {
+		return f.Locale
+	}
+	return CurrentLocale()
+}
+
+func (f *Formatter) in(t time.Time) time.Time {
+	if f.Location != nil {
+		return t.In(f.Location)
+	}
+	return t
+}
+
+// WeekdaySymbol returns abbreviated name of weekday [0, 6], 0 is Sunday
+func (f *Formatter) WeekdaySymbol(weekday int) string {
+	return f.locale().WeekdayName(weekday%7, Abbreviated)
+}
+
+// WeekdaySymbols returns abbreviated names of a week beginning on FirstWeekday, e.g. header of a calendar grid
+func (f *Formatter) WeekdaySymbols() []string {
+	if f.FirstWeekday < 0 || f.FirstWeekday > 6 {
+		panic(fmt.Sprintf("timex: invalid weekday %d", f.FirstWeekday))
+	}
+	l := make([]string, 7)
+	for i := range l {
+		l[i] = f.WeekdaySymbol(f.FirstWeekday + i)
+	}
+	return l
+}
+
+// PrettyText returns month and day, with year if it's not this year, e.g. Oct 19, Oct 19, 2025
+func (f *Formatter) PrettyText(d *Date) string {
+	l := f.locale()
+	if d.year == f.in(time.Now()).Year() {
+		return l.Format(d.t, l.Pattern("month_day"))
+	}
+	return l.Format(d.t, l.Pattern("year_month_day"))
+}
+
+// ShortText returns relative day word like Today, or weekday and PrettyText
+func (f *Formatter) ShortText(d *Date) string {
+	if w := f.relativeDayText(d); w != "" {
+		return w
+	}
+	return expandPattern(f.locale().Pattern("weekday_date"), f.WeekdaySymbol(d.weekday), f.PrettyText(d))
+}
+
+// LongText returns relative day word or weekday, followed by PrettyText
+func (f *Formatter) LongText(d *Date) string {
+	if w := f.relativeDayText(d); w != "" {
+		return expandPattern(f.locale().Pattern("relative_date"), w, f.PrettyText(d))
+	}
+	return expandPattern(f.locale().Pattern("weekday_date"), f.WeekdaySymbol(d.weekday), f.PrettyText(d))
+}
+
+// relativeDayText returns word of yesterday, today or tomorrow, or empty string
+func (f *Formatter) relativeDayText(d *Date) string {
+	if conv.AbsDuration(d.t.Sub(time.Now())) > 2*Day {
+		return ""
+	}
+	loc := f.Location
+	if loc == nil {
+		loc = d.Location()
+	}
+	for offset := -1; offset <= 1; offset++ {
+		if TodayIn(loc).Add(0, 0, offset).Equals(d) {
+			return f.locale().RelativeDay(offset)
+		}
+	}
+	return ""
+}
+
+// MonthText returns month name, with year if it's not this year
+func (f *Formatter) MonthText(m *Month) string {
+	l := f.locale()
+	if m.Year == f.in(time.Now()).Year() {
+		return l.Format(m.BeginIn(time.UTC), l.Pattern("month"))
+	}
+	return l.Format(m.BeginIn(time.UTC), l.Pattern("year_month"))
+}
+
+// TimeText returns wall clock time, e.g. 1:05PM, 下午1:05
+func (f *Formatter) TimeText(t time.Time) string {
+	return f.timeText(t, 0)
+}
+
+// TimeTextWithZero pads 12-hour clock hour with zero, e.g. 01:05PM
+func (f *Formatter) TimeTextWithZero(t time.Time) string {
+	return f.timeText(t, '0')
+}
+
+// TimeTextWithSpace pads 12-hour clock hour with space, e.g. " 1:05PM"
+func (f *Formatter) TimeTextWithSpace(t time.Time) string {
+	return f.timeText(t, ' ')
+}
+
+// timeText formats with time pattern of the hour cycle, hourPad overrides padding of 12-hour clock hour
+func (f *Formatter) timeText(t time.Time, hourPad byte) string {
+	l := f.locale()
+	t = f.in(t)
+	if f.HourCycle == HourCycle24 {
+		if GetDayTime(t) == 24*time.Hour-time.Nanosecond {
+			return "24:00"
+		}
+		return l.Format(t, l.Pattern("time_h23"))
+	}
+	if GetDayTime(t) == 24*time.Hour-time.Nanosecond {
+		return l.dayPeriods().EndOfDay
+	}
+	pattern := l.Pattern("time")
+	if f.HourCycle == HourCycle12 {
+		pattern = l.Pattern("time_h12")
+	}
+	if p := l.Pattern("time_first_hour"); p != "" && t.Hour() == 0 {
+		pattern = p
+	}
+	return l.format(t, pattern, hourPad)
+}
+
+// RelativeDateTimeText returns ShortText of the date and time, e.g. Today 01:05PM
+func (f *Formatter) RelativeDateTimeText(t time.Time) string {
+	t = f.in(t)
+	return fmt.Sprintf("%s %s", f.ShortText(DateWithTime(t)), f.TimeTextWithZero(t))
+}
+
+// RelativeText returns text of r relative to today, e.g. Today all day, Today 9:00am - 10:00am
+func (f *Formatter) RelativeText(r *Range) string {
+	l := f.locale()
+	if f.Location != nil {
+		r = r.In(f.Location)
+	}
+	begin, end := r.begin, r.end
+	beginText := f.ShortText(DateWithTime(begin))
+	if GetDayTime(begin) != 0 {
+		beginText += " " + strings.ToLower(f.TimeText(begin))
+	}
+	endText := f.ShortText(DateWithTime(end.Add(-time.Nanosecond)))
+	if GetDayTime(end) != 0 {
+		endText += " " + strings.ToLower(f.TimeText(end))
+	}
+	if r.InDay() {
+		switch {
+		case r.IsAllDay():
+			return expandPattern(l.Pattern("all_day"), beginText)
+		case begin.Equal(end):
+			return beginText
+		case GetDayTime(begin) == 0:
+			return expandPattern(l.Pattern("ends"), endText)
+		case GetDayTime(end) == 24*time.Hour-time.Nanosecond:
+			return expandPattern(l.Pattern("begins"), beginText)
+		default:
+			return expandPattern(l.Pattern("range"), beginText, strings.ToLower(f.TimeText(end)))
+		}
+	}
+	return expandPattern(l.Pattern("range"), beginText, endText)
+}
@@ -0,0 +1,73 @@
+package timex_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatter(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+	tm := time.Date(2020, 10, 19, 4, 5, 0, 0, time.UTC)
+
+	en := timex.NewFormatter("en-US", time.UTC)
+	ja := timex.NewFormatter("ja-JP", tokyo)
+	assert.Equal(t, "4:05AM", en.TimeText(tm))
+	assert.Equal(t, "04:05AM", en.TimeTextWithZero(tm))
+	assert.Equal(t, " 4:05AM", en.TimeTextWithSpace(tm))
+	assert.Equal(t, "午後1:05", ja.TimeText(tm))
+
+	d := timex.NewDate(2020, 10, 19)
+	assert.Equal(t, "Mon Oct 19, 2020", en.ShortText(d))
+	assert.Equal(t, "2020年10月19日(月)", ja.LongText(d))
+	assert.Equal(t, "Oct 2020", en.MonthText(timex.NewMonth(2020, 10)))
+
+	r := timex.NewRange(tm, tm.Add(time.Hour))
+	assert.Equal(t, "Mon Oct 19, 2020 4:05am - 5:05am", en.RelativeText(r))
+	assert.Equal(t, "2020年10月19日(月) 午後1:05～午後2:05", ja.RelativeText(r))
+}
+
+func TestFormatter_HourCycle(t *testing.T) {
+	tm := time.Date(2020, 10, 19, 13, 5, 0, 0, time.UTC)
+	f := timex.NewFormatter("en", nil)
+	f.HourCycle = timex.HourCycle24
+	assert.Equal(t, "13:05", f.TimeText(tm))
+	assert.Equal(t, "24:00", f.TimeText(timex.NewDate(2020, 10, 19).End()))
+
+	f = timex.NewFormatter("zh-Hans", nil)
+	assert.Equal(t, "下午1:05", f.TimeText(tm))
+	f.HourCycle = timex.HourCycle24
+	assert.Equal(t, "13:05", f.TimeText(tm))
+}
+
+func TestFormatter_WeekdaySymbols(t *testing.T) {
+	f := timex.NewFormatter("en", nil)
+	assert.Equal(t, []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}, f.WeekdaySymbols())
+	f.FirstWeekday = 1
+	assert.Equal(t, []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}, f.WeekdaySymbols())
+}
+
+func TestFormatter_Concurrent(t *testing.T) {
+	d := timex.NewDate(2020, 10, 19)
+	want := map[string]string{
+		"en":      "Oct 19, 2020",
+		"zh-Hans": "2020年10月19日",
+		"ko":      "2020년 10월 19일",
+	}
+	var wg sync.WaitGroup
+	for tag, text := range want {
+		f := timex.NewFormatter(tag, nil)
+		text := text
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				assert.Equal(t, text, f.PrettyText(d))
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,6 +1,6 @@
 module github.com/gopub/timex
 
-go 1.14
+go 1.16
 
 require (
 	github.com/gopub/conv v0.4.3
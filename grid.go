@@ -0,0 +1,108 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// GridOptions configures Month.Grid
+type GridOptions struct {
+	// FirstWeekday is [0, 6], 0 is Sunday
+	FirstWeekday int
+
+	// FillAdjacent fills leading and trailing cells with dates of previous and next months
+	FillAdjacent bool
+
+	// FixedRows always returns 6 rows so that grid height never changes
+	FixedRows bool
+
+	// Holidays marks holiday cells if not nil
+	Holidays HolidayProvider
+
+	// Location is time zone of cell dates and today, nil means time.Local
+	Location *time.Location
+}
+
+// GridCell is a cell in month grid
+type GridCell struct {
+	// Date is nil if the cell is outside the month and adjacent dates are not filled
+	Date      *Date
+	InMonth   bool
+	IsToday   bool
+	IsWeekend bool
+	IsHoliday bool
+	// IsMakeupWorkday is true if the cell is a weekend day which is a working day, e.g. 调休 in China
+	IsMakeupWorkday bool
+
+	// HolidayName is in current language
+	HolidayName string
+
+	// ISO 8601 week number of the row
+	Week int
+}
+
+// Grid returns month calendar as [4,6]*7 matrix. Nil opts means Sunday first grid without adjacent dates.
+func (m *Month) Grid(opts *GridOptions) [][7]*GridCell {
+	return buildGrid(m.Date(1), m.NumOfDays(), opts)
+}
+
+// buildGrid returns grid of the month which begins on first and has numOfDays days
+func buildGrid(first *Date, numOfDays int, opts *GridOptions) [][7]*GridCell {
+	if opts == nil {
+		opts = &GridOptions{}
+	}
+	if opts.FirstWeekday < 0 || opts.FirstWeekday > 6 {
+		panic(fmt.Sprintf("timex: invalid weekday %d", opts.FirstWeekday))
+	}
+	if opts.Location != nil {
+		first = first.InLocation(opts.Location)
+	}
+	offset := (first.weekday - opts.FirstWeekday + 7) % 7
+	lines := (offset + numOfDays + 6) / 7
+	if opts.FixedRows {
+		lines = 6
+	}
+
+	// date of the first cell, which may belong to previous month
+	begin := first.Add(0, 0, -offset)
+	today := TodayIn(first.Location())
+	grid := make([][7]*GridCell, lines)
+	for i := 0; i < lines; i++ {
+		_, week := begin.Add(0, 0, i*7+3).ISOWeek()
+		for j := 0; j < 7; j++ {
+			k := i*7 + j
+			c := &GridCell{
+				InMonth: k >= offset && k < offset+numOfDays,
+				Week:    week,
+			}
+			if c.InMonth || opts.FillAdjacent {
+				c.Date = begin.Add(0, 0, k)
+			}
+			if d := c.Date; d != nil {
+				c.IsToday = d.Equals(today)
+				c.IsWeekend = d.IsWeekend()
+				if opts.Holidays != nil {
+					c.HolidayName = opts.Holidays.HolidayName(d)
+					c.IsHoliday = c.HolidayName != ""
+					if w, ok := opts.Holidays.(MakeupWorkdayProvider); ok {
+						c.IsMakeupWorkday = w.IsMakeupWorkday(d)
+					}
+				}
+			}
+			grid[i][j] = c
+		}
+	}
+	return grid
+}
+
+// Badge returns "休" for holidays and "班" for make-up workdays, or empty string otherwise
+func (c *GridCell) Badge() string {
+	switch {
+	case c.IsMakeupWorkday:
+		return CurrentLocale().Word("workday_badge")
+	case c.IsHoliday:
+		return CurrentLocale().Word("holiday_badge")
+	default:
+		return ""
+	}
+}
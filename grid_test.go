@@ -0,0 +1,45 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonth_Grid(t *testing.T) {
+	m := timex.NewMonth(2020, 9)
+	g := m.Grid(nil)
+	require.Equal(t, 5, len(g))
+	assert.Nil(t, g[0][0].Date)
+	assert.False(t, g[0][0].InMonth)
+	assert.True(t, g[0][2].InMonth)
+	assert.True(t, g[0][2].Date.Equals(timex.NewDate(2020, 9, 1)))
+
+	g = m.Grid(&timex.GridOptions{
+		FirstWeekday: 1,
+		FillAdjacent: true,
+		FixedRows:    true,
+	})
+	require.Equal(t, 6, len(g))
+	assert.True(t, g[0][0].Date.Equals(timex.NewDate(2020, 8, 31)))
+	assert.False(t, g[0][0].InMonth)
+	assert.Equal(t, 36, g[0][0].Week)
+	assert.True(t, g[0][5].IsWeekend)
+	assert.False(t, g[0][4].IsWeekend)
+	assert.True(t, g[5][6].Date.Equals(timex.NewDate(2020, 10, 11)))
+	assert.Equal(t, 41, g[5][6].Week)
+
+	g = timex.CurrentMonth().Grid(&timex.GridOptions{FillAdjacent: true})
+	n := 0
+	for _, row := range g {
+		for _, c := range row {
+			if c.IsToday {
+				n++
+				assert.True(t, c.InMonth)
+			}
+		}
+	}
+	assert.Equal(t, 1, n)
+}
@@ -0,0 +1,119 @@
+package timex
+
+// hebrewEpoch is Julian Day Number of 1 Tishrei AM 1, i.e. October 7, 3761 BCE in Julian calendar
+const hebrewEpoch = 347998
+
+type hebrewSystem struct{}
+
+// Hebrew is the arithmetic Hebrew calendar. Months are numbered from Tishrei, so Nisan is month 7 in a common year and month 8 in a leap year.
+var Hebrew CalendarSystem = hebrewSystem{}
+
+func (hebrewSystem) ID() string {
+	return "hebrew"
+}
+
+func (hebrewSystem) IsLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+func (s hebrewSystem) NumOfMonths(year int) int {
+	if s.IsLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// monthIndex returns index of month in hebrewMonthNames
+func (s hebrewSystem) monthIndex(year, month int) int {
+	if s.IsLeapYear(year) || month < 6 {
+		return month - 1
+	}
+	if month == 6 {
+		return 13
+	}
+	return month
+}
+
+func (s hebrewSystem) NumOfMonthDays(year, month int) int {
+	switch s.monthIndex(year, month) {
+	case 1:
+		if s.numOfYearDays(year)%10 == 5 {
+			return 30
+		}
+		return 29
+	case 2:
+		if s.numOfYearDays(year)%10 == 3 {
+			return 29
+		}
+		return 30
+	case 0, 4, 5, 7, 9, 11:
+		return 30
+	default:
+		return 29
+	}
+}
+
+func (s hebrewSystem) MonthName(year, month int) string {
+	return localizedName("hebrew_months", s.monthIndex(year, month))
+}
+
+// elapsedDays returns days from epoch to the molad of Tishrei of year, with postponement rules
+func (hebrewSystem) elapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	day := 29*months + floorDiv(parts, 25920)
+	if mod(3*(day+1), 7) < 3 {
+		day++
+	}
+	return day
+}
+
+func (s hebrewSystem) newYear(year int) int {
+	ny0, ny1, ny2 := s.elapsedDays(year-1), s.elapsedDays(year), s.elapsedDays(year+1)
+	correction := 0
+	if ny2-ny1 == 356 {
+		correction = 2
+	} else if ny1-ny0 == 382 {
+		correction = 1
+	}
+	return hebrewEpoch + ny1 + correction
+}
+
+func (s hebrewSystem) numOfYearDays(year int) int {
+	return s.newYear(year+1) - s.newYear(year)
+}
+
+func (s hebrewSystem) julianDayNumber(year, month, day int) int {
+	jdn := s.newYear(year)
+	for m := 1; m < month; m++ {
+		jdn += s.NumOfMonthDays(year, m)
+	}
+	return jdn + day - 1
+}
+
+func (s hebrewSystem) ToDate(year, month, day int) (*Date, error) {
+	if err := checkCalendarDate(s, year, month, day); err != nil {
+		return nil, err
+	}
+	return dateOfJulianDayNumber(s.julianDayNumber(year, month, day)), nil
+}
+
+func (s hebrewSystem) FromDate(d *Date) (*CalendarDate, error) {
+	jdn := julianDayNumber(d.year, d.month, d.day)
+	year := d.year + 3761
+	if jdn < s.newYear(year) {
+		year--
+	}
+	day := jdn - s.newYear(year) + 1
+	month := 1
+	for n := s.NumOfMonthDays(year, month); day > n; n = s.NumOfMonthDays(year, month) {
+		day -= n
+		month++
+	}
+	return &CalendarDate{
+		System: s,
+		Year:   year,
+		Month:  month,
+		Day:    day,
+	}, nil
+}
@@ -0,0 +1,291 @@
+package timex
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// HolidayProvider provides public holidays
+type HolidayProvider interface {
+	// Holidays returns holidays defined for the year, sorted by date
+	Holidays(year int) []*Holiday
+	IsHoliday(d *Date) bool
+	// HolidayName returns name in current language, or empty string if d is not a holiday
+	HolidayName(d *Date) string
+}
+
+// Holiday is a day off. Date is the day off, which differs from ActualDate if the holiday is substituted.
+type Holiday struct {
+	Date       *Date `json:"date"`
+	ActualDate *Date `json:"actual_date"`
+	// lang => name, e.g. en => Christmas Day, zh-Hans => 圣诞节
+	Names map[string]string `json:"names"`
+}
+
+// Name returns name in current language
+func (h *Holiday) Name() string {
+	return localize(h.Names)
+}
+
+func (h *Holiday) IsObserved() bool {
+	return !h.Date.Equals(h.ActualDate)
+}
+
+func (h *Holiday) String() string {
+	return fmt.Sprintf("%s %s", h.Date, h.Names["en"])
+}
+
+func (h *Holiday) falls(d *Date) bool {
+	return h.Date.Equals(d) || h.ActualDate.Equals(d)
+}
+
+// HolidayRule computes the date of a holiday in a year
+type HolidayRule interface {
+	// DateIn returns nil if the holiday doesn't happen in year
+	DateIn(year int) *Date
+}
+
+type fixedDateRule struct {
+	month int
+	day   int
+}
+
+func (r *fixedDateRule) DateIn(year int) *Date {
+	return NewDate(year, r.month, r.day)
+}
+
+// FixedDate returns a rule for holidays on the same date every year, e.g. Christmas
+func FixedDate(month, day int) HolidayRule {
+	return &fixedDateRule{month: month, day: day}
+}
+
+type nthWeekdayRule struct {
+	month   int
+	weekday int
+	n       int
+}
+
+func (r *nthWeekdayRule) DateIn(year int) *Date {
+	if r.n > 0 {
+		first := NewDate(year, r.month, 1)
+		return first.Add(0, 0, (r.weekday-first.weekday+7)%7+(r.n-1)*7)
+	}
+	last := NewDate(year, r.month+1, 0)
+	return last.Add(0, 0, -((last.weekday-r.weekday+7)%7)+(r.n+1)*7)
+}
+
+// NthWeekday returns a rule for holidays on the nth weekday of month, e.g. Thanksgiving is NthWeekday(11, 4, 4).
+// Negative n counts from the end of month, -1 is the last weekday of month.
+func NthWeekday(month, weekday, n int) HolidayRule {
+	if n == 0 || n > 5 || n < -5 {
+		panic(fmt.Sprintf("timex: invalid n %d", n))
+	}
+	return &nthWeekdayRule{month: month, weekday: weekday, n: n}
+}
+
+type easterRule struct {
+	offset int
+}
+
+func (r *easterRule) DateIn(year int) *Date {
+	return Easter(year).Add(0, 0, r.offset)
+}
+
+// EasterOffset returns a rule for holidays relative to Easter Sunday, e.g. Good Friday is EasterOffset(-2)
+func EasterOffset(days int) HolidayRule {
+	return &easterRule{offset: days}
+}
+
+// Easter returns Easter Sunday of year in Gregorian calendar, computed by anonymous Gregorian algorithm
+func Easter(year int) *Date {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return NewDate(year, month, day)
+}
+
+// Observance decides the day off when a holiday falls on weekend
+type Observance int
+
+const (
+	NotObserved Observance = iota
+	// ObserveNearestWeekday moves Saturday to Friday and Sunday to Monday, e.g. US federal holidays
+	ObserveNearestWeekday
+	// ObserveNextWeekday moves to the next weekday which is not a holiday, e.g. UK bank holidays
+	ObserveNextWeekday
+)
+
+// HolidayDef defines a rule based holiday
+type HolidayDef struct {
+	Names      map[string]string
+	Rule       HolidayRule
+	Observance Observance
+	// FromYear and ToYear limit the years in which the holiday is defined, zero means no limit
+	FromYear int
+	ToYear   int
+}
+
+func (h *HolidayDef) validIn(year int) bool {
+	return (h.FromYear == 0 || year >= h.FromYear) && (h.ToYear == 0 || year <= h.ToYear)
+}
+
+// RuleHolidayProvider computes holidays with rules
+type RuleHolidayProvider struct {
+	defs  []*HolidayDef
+	years sync.Map
+}
+
+var _ HolidayProvider = (*RuleHolidayProvider)(nil)
+
+func NewRuleHolidayProvider(defs ...*HolidayDef) *RuleHolidayProvider {
+	return &RuleHolidayProvider{
+		defs: defs,
+	}
+}
+
+func (p *RuleHolidayProvider) Holidays(year int) []*Holiday {
+	if v, ok := p.years.Load(year); ok {
+		return v.([]*Holiday)
+	}
+	// observances are kept with holidays because defs not in year are skipped
+	type observed struct {
+		h   *Holiday
+		obs Observance
+	}
+	var built []observed
+	taken := map[Date]bool{}
+	for _, def := range p.defs {
+		if !def.validIn(year) {
+			continue
+		}
+		d := def.Rule.DateIn(year)
+		if d == nil {
+			continue
+		}
+		built = append(built, observed{&Holiday{Date: d, ActualDate: d, Names: def.Names}, def.Observance})
+		if !d.IsWeekend() {
+			taken[dateKey(d)] = true
+		}
+	}
+	l := make([]*Holiday, len(built))
+	for i, v := range built {
+		h := v.h
+		l[i] = h
+		if !h.Date.IsWeekend() {
+			continue
+		}
+		switch v.obs {
+		case ObserveNearestWeekday:
+			if h.Date.weekday == 6 {
+				h.Date = h.Date.Add(0, 0, -1)
+			} else {
+				h.Date = h.Date.Add(0, 0, 1)
+			}
+		case ObserveNextWeekday:
+			d := h.Date
+			for d.IsWeekend() || taken[dateKey(d)] {
+				d = d.Add(0, 0, 1)
+			}
+			h.Date = d
+			taken[dateKey(d)] = true
+		}
+	}
+	sortHolidays(l)
+	v, _ := p.years.LoadOrStore(year, l)
+	return v.([]*Holiday)
+}
+
+func (p *RuleHolidayProvider) Holiday(d *Date) *Holiday {
+	return findHoliday(p, d)
+}
+
+func (p *RuleHolidayProvider) IsHoliday(d *Date) bool {
+	return findHoliday(p, d) != nil
+}
+
+func (p *RuleHolidayProvider) HolidayName(d *Date) string {
+	if h := findHoliday(p, d); h != nil {
+		return h.Name()
+	}
+	return ""
+}
+
+// findHoliday looks up adjacent years as observed day may cross year boundary
+func findHoliday(p HolidayProvider, d *Date) *Holiday {
+	for y := d.year - 1; y <= d.year+1; y++ {
+		for _, h := range p.Holidays(y) {
+			if h.falls(d) {
+				return h
+			}
+		}
+	}
+	return nil
+}
+
+func sortHolidays(l []*Holiday) {
+	sort.SliceStable(l, func(i, j int) bool {
+		return daysBetween(l[i].Date, l[j].Date) > 0
+	})
+}
+
+// dateKey returns a comparable value of date without time zone
+func dateKey(d *Date) Date {
+	return Date{year: d.year, month: d.month, day: d.day}
+}
+
+type holidayProviders []HolidayProvider
+
+// ComposeHolidayProviders combines several providers, e.g. national and regional holidays.
+// Name lookup returns the name from the first provider which has a holiday on the date.
+// The result implements MakeupWorkdayProvider if any of providers implements it.
+func ComposeHolidayProviders(providers ...HolidayProvider) HolidayProvider {
+	return holidayProviders(providers)
+}
+
+func (l holidayProviders) Holidays(year int) []*Holiday {
+	var res []*Holiday
+	for _, p := range l {
+		res = append(res, p.Holidays(year)...)
+	}
+	sortHolidays(res)
+	return res
+}
+
+func (l holidayProviders) IsHoliday(d *Date) bool {
+	for _, p := range l {
+		if p.IsHoliday(d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l holidayProviders) HolidayName(d *Date) string {
+	for _, p := range l {
+		if s := p.HolidayName(d); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func (l holidayProviders) IsMakeupWorkday(d *Date) bool {
+	for _, p := range l {
+		if w, ok := p.(MakeupWorkdayProvider); ok && w.IsMakeupWorkday(d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEaster(t *testing.T) {
+	assert.True(t, timex.Easter(2024).Equals(timex.NewDate(2024, 3, 31)))
+	assert.True(t, timex.Easter(2025).Equals(timex.NewDate(2025, 4, 20)))
+	assert.True(t, timex.Easter(2026).Equals(timex.NewDate(2026, 4, 5)))
+	assert.True(t, timex.Easter(2038).Equals(timex.NewDate(2038, 4, 25)))
+}
+
+func TestUSHolidayProvider(t *testing.T) {
+	p := timex.NewUSHolidayProvider()
+	l := p.Holidays(2026)
+	require.Equal(t, 11, len(l))
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 11, 26)))
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 5, 25)))
+	assert.Equal(t, "Thanksgiving Day", p.HolidayName(timex.NewDate(2026, 11, 26)))
+	// Independence Day on Saturday is observed on Friday
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 7, 3)))
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 7, 4)))
+	// New Year's Day 2022 is observed on 2021-12-31
+	assert.True(t, p.IsHoliday(timex.NewDate(2021, 12, 31)))
+	assert.False(t, p.IsHoliday(timex.NewDate(2020, 6, 19)))
+	assert.False(t, p.IsHoliday(timex.NewDate(2026, 11, 27)))
+}
+
+func TestUSHolidayProvider_BeforeJuneteenth(t *testing.T) {
+	p := timex.NewUSHolidayProvider()
+	require.Equal(t, 10, len(p.Holidays(2016)))
+	// Christmas Day 2016 on Sunday is observed on Monday
+	assert.True(t, p.IsHoliday(timex.NewDate(2016, 12, 26)))
+	// Veterans Day 2018 on Sunday is observed on Monday
+	assert.True(t, p.IsHoliday(timex.NewDate(2018, 11, 12)))
+	// Independence Day 2020 on Saturday is observed on Friday
+	assert.True(t, p.IsHoliday(timex.NewDate(2020, 7, 3)))
+}
+
+func TestUKHolidayProvider(t *testing.T) {
+	p := timex.NewUKHolidayProvider()
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 4, 3)))
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 4, 6)))
+	// Christmas on Saturday and Boxing Day on Sunday
+	assert.True(t, p.IsHoliday(timex.NewDate(2021, 12, 27)))
+	assert.True(t, p.IsHoliday(timex.NewDate(2021, 12, 28)))
+	// Christmas on Sunday is substituted on Tuesday
+	assert.Equal(t, "Christmas Day", p.HolidayName(timex.NewDate(2022, 12, 27)))
+	assert.Equal(t, "Boxing Day", p.HolidayName(timex.NewDate(2022, 12, 26)))
+}
+
+func TestComposeHolidayProviders(t *testing.T) {
+	p := timex.ComposeHolidayProviders(timex.NewUKHolidayProvider(), timex.NewCNHolidayProvider())
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 10, 2)))
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 12, 25)))
+	l := p.Holidays(2026)
+	for i := 1; i < len(l); i++ {
+		assert.False(t, l[i].Date.Before(l[i-1].Date))
+	}
+
+	g := timex.NewMonth(2026, 10).Grid(&timex.GridOptions{Holidays: p})
+	assert.True(t, g[0][4].IsHoliday)
+	assert.Equal(t, "National Day", g[0][4].HolidayName)
+}
+
+func TestCNStatutoryHolidayProvider(t *testing.T) {
+	p := timex.NewCNStatutoryHolidayProvider()
+	assert.Contains(t, p.Years(), 2026)
+	assert.True(t, p.IsHoliday(timex.NewDate(2026, 2, 17)))
+	assert.Equal(t, "Spring Festival", p.HolidayName(timex.NewDate(2026, 2, 17)))
+	assert.True(t, p.IsMakeupWorkday(timex.NewDate(2026, 2, 28)))
+	assert.False(t, p.IsMakeupWorkday(timex.NewDate(2026, 3, 7)))
+	assert.True(t, p.IsHoliday(timex.NewDate(2023, 12, 30)))
+	assert.Equal(t, 33, len(p.Holidays(2026)))
+
+	timex.SetLang("zh-Hant")
+	assert.Equal(t, "春節", p.HolidayName(timex.NewDate(2026, 2, 17)))
+	// US holidays have no zh-Hant names and fall back to zh-Hans
+	assert.Equal(t, "元旦", timex.NewUSHolidayProvider().HolidayName(timex.NewDate(2026, 1, 1)))
+	timex.SetLang("en")
+
+	err := p.Load([]byte(`[{"year": 2027, "en": "New Year's Day", "zh-Hans": "元旦", "begin": "2027-01-01", "end": "2027-01-03", "workdays": ["2027-01-09"]}]`))
+	require.NoError(t, err)
+	assert.True(t, p.IsHoliday(timex.NewDate(2027, 1, 2)))
+	assert.True(t, p.IsMakeupWorkday(timex.NewDate(2027, 1, 9)))
+	assert.Error(t, p.Load([]byte(`[{"year": 2027, "begin": "2027-01-03", "end": "2027-01-01"}]`)))
+
+	g := timex.NewMonth(2026, 2).Grid(&timex.GridOptions{
+		Holidays: timex.ComposeHolidayProviders(p),
+	})
+	// 2026-02-14 is Saturday
+	c := g[1][6]
+	require.True(t, c.Date.Equals(timex.NewDate(2026, 2, 14)))
+	assert.True(t, c.IsMakeupWorkday)
+	assert.Equal(t, "Work", c.Badge())
+	assert.Equal(t, "Off", g[2][0].Badge())
+}
@@ -0,0 +1,158 @@
+package timex
+
+func names(en, zhHans string) map[string]string {
+	return map[string]string{"en": en, "zh-Hans": zhHans}
+}
+
+// NewUSHolidayProvider returns US federal holidays
+func NewUSHolidayProvider() *RuleHolidayProvider {
+	return NewRuleHolidayProvider(
+		&HolidayDef{
+			Names:      names("New Year's Day", "元旦"),
+			Rule:       FixedDate(1, 1),
+			Observance: ObserveNearestWeekday,
+		},
+		&HolidayDef{
+			Names: names("Martin Luther King Jr. Day", "马丁·路德·金纪念日"),
+			Rule:  NthWeekday(1, 1, 3),
+		},
+		&HolidayDef{
+			Names: names("Washington's Birthday", "华盛顿诞辰纪念日"),
+			Rule:  NthWeekday(2, 1, 3),
+		},
+		&HolidayDef{
+			Names: names("Memorial Day", "阵亡将士纪念日"),
+			Rule:  NthWeekday(5, 1, -1),
+		},
+		&HolidayDef{
+			Names:      names("Juneteenth", "六月节"),
+			Rule:       FixedDate(6, 19),
+			Observance: ObserveNearestWeekday,
+			FromYear:   2021,
+		},
+		&HolidayDef{
+			Names:      names("Independence Day", "独立日"),
+			Rule:       FixedDate(7, 4),
+			Observance: ObserveNearestWeekday,
+		},
+		&HolidayDef{
+			Names: names("Labor Day", "劳动节"),
+			Rule:  NthWeekday(9, 1, 1),
+		},
+		&HolidayDef{
+			Names: names("Columbus Day", "哥伦布日"),
+			Rule:  NthWeekday(10, 1, 2),
+		},
+		&HolidayDef{
+			Names:      names("Veterans Day", "退伍军人节"),
+			Rule:       FixedDate(11, 11),
+			Observance: ObserveNearestWeekday,
+		},
+		&HolidayDef{
+			Names: names("Thanksgiving Day", "感恩节"),
+			Rule:  NthWeekday(11, 4, 4),
+		},
+		&HolidayDef{
+			Names:      names("Christmas Day", "圣诞节"),
+			Rule:       FixedDate(12, 25),
+			Observance: ObserveNearestWeekday,
+		},
+	)
+}
+
+// NewUKHolidayProvider returns bank holidays in England and Wales
+func NewUKHolidayProvider() *RuleHolidayProvider {
+	return NewRuleHolidayProvider(
+		&HolidayDef{
+			Names:      names("New Year's Day", "元旦"),
+			Rule:       FixedDate(1, 1),
+			Observance: ObserveNextWeekday,
+		},
+		&HolidayDef{
+			Names: names("Good Friday", "耶稣受难日"),
+			Rule:  EasterOffset(-2),
+		},
+		&HolidayDef{
+			Names: names("Easter Monday", "复活节星期一"),
+			Rule:  EasterOffset(1),
+		},
+		&HolidayDef{
+			Names: names("Early May bank holiday", "五月初银行假日"),
+			Rule:  NthWeekday(5, 1, 1),
+		},
+		&HolidayDef{
+			Names: names("Spring bank holiday", "春季银行假日"),
+			Rule:  NthWeekday(5, 1, -1),
+		},
+		&HolidayDef{
+			Names: names("Summer bank holiday", "夏季银行假日"),
+			Rule:  NthWeekday(8, 1, -1),
+		},
+		&HolidayDef{
+			Names:      names("Christmas Day", "圣诞节"),
+			Rule:       FixedDate(12, 25),
+			Observance: ObserveNextWeekday,
+		},
+		&HolidayDef{
+			Names:      names("Boxing Day", "节礼日"),
+			Rule:       FixedDate(12, 26),
+			Observance: ObserveNextWeekday,
+		},
+	)
+}
+
+// NewCNHolidayProvider returns Chinese public holidays computed by rules.
+// Days off are adjusted yearly by the State Council, which rules can't compute. See NewCNStatutoryHolidayProvider.
+func NewCNHolidayProvider() *RuleHolidayProvider {
+	return NewRuleHolidayProvider(
+		&HolidayDef{
+			Names: names("New Year's Day", "元旦"),
+			Rule:  FixedDate(1, 1),
+		},
+		&HolidayDef{
+			Names:    names("Chinese New Year's Eve", "除夕"),
+			Rule:     ChineseNewYearsEve,
+			FromYear: 2025,
+		},
+		&HolidayDef{
+			Names: names("Spring Festival", "春节"),
+			Rule:  SpringFestival,
+		},
+		&HolidayDef{
+			Names: names("Spring Festival", "春节"),
+			Rule:  &LunarRecurrence{Month: 1, Day: 2},
+		},
+		&HolidayDef{
+			Names: names("Spring Festival", "春节"),
+			Rule:  &LunarRecurrence{Month: 1, Day: 3},
+		},
+		&HolidayDef{
+			Names: names("Qingming Festival", "清明节"),
+			Rule:  PureBrightness,
+		},
+		&HolidayDef{
+			Names: names("Labour Day", "劳动节"),
+			Rule:  FixedDate(5, 1),
+		},
+		&HolidayDef{
+			Names: names("Dragon Boat Festival", "端午节"),
+			Rule:  DragonBoatFestival,
+		},
+		&HolidayDef{
+			Names: names("Mid-Autumn Festival", "中秋节"),
+			Rule:  MidAutumnFestival,
+		},
+		&HolidayDef{
+			Names: names("National Day", "国庆节"),
+			Rule:  FixedDate(10, 1),
+		},
+		&HolidayDef{
+			Names: names("National Day", "国庆节"),
+			Rule:  FixedDate(10, 2),
+		},
+		&HolidayDef{
+			Names: names("National Day", "国庆节"),
+			Rule:  FixedDate(10, 3),
+		},
+	)
+}
@@ -0,0 +1,70 @@
+package timex
+
+// islamicEpoch is Julian Day Number of 1 Muharram 1 AH, Friday July 16, 622 in Julian calendar
+const islamicEpoch = 1948440
+
+type islamicSystem struct{}
+
+// IslamicTabular is the arithmetic Islamic (Hijri) calendar with civil (Friday) epoch, CLDR islamic-civil, and leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29 of 30-year cycle.
+// Religious dates based on moon sighting may differ by a day or two.
+var IslamicTabular CalendarSystem = islamicSystem{}
+
+func (islamicSystem) ID() string {
+	return "islamic-civil"
+}
+
+func (islamicSystem) IsLeapYear(year int) bool {
+	return mod(14+11*year, 30) < 11
+}
+
+func (islamicSystem) NumOfMonths(year int) int {
+	return 12
+}
+
+func (s islamicSystem) NumOfMonthDays(year, month int) int {
+	if month%2 == 1 || (month == 12 && s.IsLeapYear(year)) {
+		return 30
+	}
+	return 29
+}
+
+func (islamicSystem) MonthName(year, month int) string {
+	return localizedName("islamic_months", month-1)
+}
+
+func (islamicSystem) julianDayNumber(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + floorDiv(3+11*year, 30) + islamicEpoch - 1
+}
+
+func (s islamicSystem) ToDate(year, month, day int) (*Date, error) {
+	if err := checkCalendarDate(s, year, month, day); err != nil {
+		return nil, err
+	}
+	return dateOfJulianDayNumber(s.julianDayNumber(year, month, day)), nil
+}
+
+func (s islamicSystem) FromDate(d *Date) (*CalendarDate, error) {
+	jdn := julianDayNumber(d.year, d.month, d.day)
+	year := floorDiv(30*(jdn-islamicEpoch)+10646, 10631)
+	month := 12
+	for m := 1; m < 12; m++ {
+		if jdn < s.julianDayNumber(year, m+1, 1) {
+			month = m
+			break
+		}
+	}
+	return &CalendarDate{
+		System: s,
+		Year:   year,
+		Month:  month,
+		Day:    jdn - s.julianDayNumber(year, month, 1) + 1,
+	}, nil
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,188 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// modifiedJulianDayOffset is Julian Day Number of 1858-11-16, the day before MJD 0 begins at midnight
+	modifiedJulianDayOffset = 2400001
+)
+
+// JulianDayNumber returns Julian Day Number of d, i.e. number of days since Monday, January 1, 4713 BC in proleptic Julian calendar
+func (d *Date) JulianDayNumber() int {
+	return julianDayNumber(d.year, d.month, d.day)
+}
+
+// ModifiedJulianDate returns Modified Julian Date of d at midnight, which is 0 on 1858-11-17
+func (d *Date) ModifiedJulianDate() int {
+	return d.JulianDayNumber() - modifiedJulianDayOffset
+}
+
+// DateWithJulianDayNumber returns date of Julian Day Number jdn
+func DateWithJulianDayNumber(jdn int) *Date {
+	return dateOfJulianDayNumber(jdn)
+}
+
+// DateWithModifiedJulianDate returns date of Modified Julian Date mjd
+func DateWithModifiedJulianDate(mjd int) *Date {
+	return dateOfJulianDayNumber(mjd + modifiedJulianDayOffset)
+}
+
+// JulianDay returns Julian Day of t in UT, e.g. 2451545.0 is 2000-01-01 12:00 UTC
+func JulianDay(t time.Time) float64 {
+	return julianDay(t)
+}
+
+// TimeWithJulianDay returns the UTC time of Julian Day jd
+func TimeWithJulianDay(jd float64) time.Time {
+	return timeOfJulianDay(jd)
+}
+
+// julianCalendarDayNumber returns Julian Day Number of date in Julian calendar
+func julianCalendarDayNumber(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+// julianCalendarDate returns date in Julian calendar of Julian Day Number
+func julianCalendarDate(jdn int) (year, month, day int) {
+	c := jdn + 32082
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day = e - (153*m+2)/5 + 1
+	month = m + 3 - 12*(m/10)
+	year = d - 4800 + m/10
+	return year, month, day
+}
+
+type julianSystem struct{}
+
+// Julian is the proleptic Julian calendar
+var Julian CalendarSystem = julianSystem{}
+
+func (julianSystem) ID() string {
+	return "julian"
+}
+
+func (s julianSystem) FromDate(d *Date) (*CalendarDate, error) {
+	y, m, day := julianCalendarDate(d.JulianDayNumber())
+	return &CalendarDate{System: s, Year: y, Month: m, Day: day}, nil
+}
+
+func (s julianSystem) ToDate(year, month, day int) (*Date, error) {
+	if err := checkCalendarDate(s, year, month, day); err != nil {
+		return nil, err
+	}
+	return dateOfJulianDayNumber(julianCalendarDayNumber(year, month, day)), nil
+}
+
+func (julianSystem) IsLeapYear(year int) bool {
+	return mod(year, 4) == 0
+}
+
+func (julianSystem) NumOfMonths(year int) int {
+	return 12
+}
+
+func (s julianSystem) NumOfMonthDays(year, month int) int {
+	if month == 2 && s.IsLeapYear(year) {
+		return 29
+	}
+	return Gregorian.NumOfMonthDays(2001, month)
+}
+
+func (julianSystem) MonthName(year, month int) string {
+	return Gregorian.MonthName(year, month)
+}
+
+// JulianGregorianCalendar uses Julian calendar before the reform date and Gregorian calendar since then.
+// Days skipped by the reform don't exist, e.g. 1582-10-05 to 1582-10-14.
+type JulianGregorianCalendar struct {
+	reform    *Date
+	reformJDN int
+}
+
+// JulianGregorian is the calendar with Gregorian reform on 1582-10-15
+var JulianGregorian = NewJulianGregorianCalendar(NewDate(1582, 10, 15))
+
+// NewJulianGregorianCalendar creates a calendar whose first Gregorian day is reform, e.g. 1752-09-14 in Great Britain and its colonies
+func NewJulianGregorianCalendar(reform *Date) *JulianGregorianCalendar {
+	return &JulianGregorianCalendar{
+		reform:    reform,
+		reformJDN: reform.JulianDayNumber(),
+	}
+}
+
+var _ CalendarSystem = (*JulianGregorianCalendar)(nil)
+
+func (c *JulianGregorianCalendar) ID() string {
+	return "julian-gregorian"
+}
+
+// Reform returns the first Gregorian day
+func (c *JulianGregorianCalendar) Reform() *Date {
+	return c.reform
+}
+
+func (c *JulianGregorianCalendar) FromDate(d *Date) (*CalendarDate, error) {
+	if jdn := d.JulianDayNumber(); jdn < c.reformJDN {
+		y, m, day := julianCalendarDate(jdn)
+		return &CalendarDate{System: c, Year: y, Month: m, Day: day}, nil
+	}
+	return &CalendarDate{System: c, Year: d.year, Month: d.month, Day: d.day}, nil
+}
+
+func (c *JulianGregorianCalendar) ToDate(year, month, day int) (*Date, error) {
+	if month < 1 || month > 12 {
+		return nil, fmt.Errorf("invalid month %d in %s year %d", month, c.ID(), year)
+	}
+	if jdn := julianCalendarDayNumber(year, month, day); jdn < c.reformJDN {
+		if err := checkCalendarDate(Julian, year, month, day); err != nil {
+			return nil, err
+		}
+		return dateOfJulianDayNumber(jdn), nil
+	}
+	if err := checkCalendarDate(Gregorian, year, month, day); err != nil {
+		return nil, err
+	}
+	if julianDayNumber(year, month, day) < c.reformJDN {
+		return nil, fmt.Errorf("%d-%d-%d is skipped by Gregorian reform on %s", year, month, day, c.reform)
+	}
+	return NewDate(year, month, day), nil
+}
+
+// IsLeapYear follows Julian rule if February of year is before the reform
+func (c *JulianGregorianCalendar) IsLeapYear(year int) bool {
+	if julianCalendarDayNumber(year, 3, 1) <= c.reformJDN {
+		return Julian.IsLeapYear(year)
+	}
+	return IsLeap(year)
+}
+
+func (c *JulianGregorianCalendar) NumOfMonths(year int) int {
+	return 12
+}
+
+// NumOfMonthDays returns number of existing days in the month, e.g. 21 in October 1582
+func (c *JulianGregorianCalendar) NumOfMonthDays(year, month int) int {
+	if month == 12 {
+		return c.firstDayOfMonth(year+1, 1) - c.firstDayOfMonth(year, 12)
+	}
+	return c.firstDayOfMonth(year, month+1) - c.firstDayOfMonth(year, month)
+}
+
+func (c *JulianGregorianCalendar) firstDayOfMonth(year, month int) int {
+	if jdn := julianCalendarDayNumber(year, month, 1); jdn < c.reformJDN {
+		return jdn
+	}
+	return julianDayNumber(year, month, 1)
+}
+
+func (c *JulianGregorianCalendar) MonthName(year, month int) string {
+	return Gregorian.MonthName(year, month)
+}
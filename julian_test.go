@@ -0,0 +1,68 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate_JulianDayNumber(t *testing.T) {
+	d := timex.NewDate(2000, 1, 1)
+	assert.Equal(t, 2451545, d.JulianDayNumber())
+	assert.Equal(t, 51544, d.ModifiedJulianDate())
+	assert.Equal(t, 0, timex.NewDate(1858, 11, 17).ModifiedJulianDate())
+	assert.True(t, timex.DateWithJulianDayNumber(2299161).Equals(timex.NewDate(1582, 10, 15)))
+	assert.True(t, timex.DateWithModifiedJulianDate(61332).Equals(timex.NewDate(2026, 10, 19)))
+	// JDN 0 is 4713 BC January 1 in proleptic Julian calendar
+	assert.Equal(t, "-4712-1-1", timex.DateWithJulianDayNumber(0).In(timex.Julian).String())
+
+	tm := time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, 2451545.0, timex.JulianDay(tm))
+	assert.True(t, timex.TimeWithJulianDay(2451545.0).Equal(tm))
+}
+
+func TestJulian(t *testing.T) {
+	c := timex.NewDate(1582, 10, 15).In(timex.Julian)
+	assert.Equal(t, "1582-10-5", c.String())
+	d, err := timex.Julian.ToDate(1900, 2, 29)
+	require.NoError(t, err)
+	assert.True(t, d.Equals(timex.NewDate(1900, 3, 13)))
+	assert.False(t, timex.Gregorian.IsLeapYear(1900))
+	assert.True(t, timex.Julian.IsLeapYear(1900))
+}
+
+func TestJulianGregorianCalendar(t *testing.T) {
+	c := timex.JulianGregorian
+	assert.Equal(t, "1582-10-4", timex.NewDate(1582, 10, 14).In(c).String())
+	assert.Equal(t, "1582-10-15", timex.NewDate(1582, 10, 15).In(c).String())
+	_, err := c.ToDate(1582, 10, 10)
+	assert.Error(t, err)
+	d, err := c.ToDate(1582, 10, 4)
+	require.NoError(t, err)
+	assert.Equal(t, "1582-10-15", d.Add(0, 0, 1).In(c).String())
+	assert.Equal(t, 21, c.NumOfMonthDays(1582, 10))
+	assert.True(t, c.IsLeapYear(1500))
+	assert.False(t, c.IsLeapYear(1700))
+
+	m := timex.NewSystemMonth(c, 1582, 10)
+	g := m.Grid(&timex.GridOptions{})
+	var days []int
+	for _, row := range g {
+		for _, cell := range row {
+			if cell.InMonth {
+				days = append(days, cell.Date.In(c).Day)
+			}
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 15, 16}, days[:6])
+	assert.Equal(t, 31, days[len(days)-1])
+	assert.Equal(t, 21, int(m.Range().Duration()/timex.Day))
+
+	uk := timex.NewJulianGregorianCalendar(timex.NewDate(1752, 9, 14))
+	assert.Equal(t, "1752-9-2", timex.NewDate(1752, 9, 13).In(uk).String())
+	assert.True(t, uk.IsLeapYear(1700))
+	assert.Equal(t, 19, uk.NumOfMonthDays(1752, 9))
+}
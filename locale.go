@@ -0,0 +1,608 @@
+package timex
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Width is the length of month and weekday names
+type Width int
+
+const (
+	// Wide is the full name, e.g. January, Monday
+	Wide Width = iota
+	// Abbreviated is the short name, e.g. Jan, Mon
+	Abbreviated
+	// Narrow is the shortest name, e.g. J, M
+	Narrow
+)
+
+// NameWidths are names in wide, abbreviated and narrow forms
+type NameWidths struct {
+	Wide        []string `json:"wide"`
+	Abbreviated []string `json:"abbreviated"`
+	Narrow      []string `json:"narrow"`
+}
+
+// get returns names of width w, missing abbreviated and narrow forms are derived from the wide names,
+// because parent is in another language. It returns nil if w can't be derived.
+func (n *NameWidths) get(w Width) []string {
+	switch w {
+	case Abbreviated:
+		if n.Abbreviated != nil {
+			return n.Abbreviated
+		}
+		return n.Wide
+	case Narrow:
+		if n.Narrow != nil {
+			return n.Narrow
+		}
+		abbr := n.get(Abbreviated)
+		if abbr == nil {
+			return nil
+		}
+		l := make([]string, len(abbr))
+		for i, s := range abbr {
+			l[i] = string([]rune(s)[:1])
+		}
+		return l
+	default:
+		return n.Wide
+	}
+}
+
+// DayPeriods are names of parts of a day
+type DayPeriods struct {
+	AM string `json:"am"`
+	PM string `json:"pm"`
+	// Noon is used for 12:xx by pattern letter B if not empty, e.g. 中午
+	Noon string `json:"noon"`
+	// EndOfDay is text of 24:00, e.g. Midnight
+	EndOfDay string `json:"end_of_day"`
+}
+
+// PluralCategory is CLDR plural category: zero, one, two, few, many or other
+type PluralCategory string
+
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+// pluralRules select plural category of an integer, keyed by Locale.PluralRule
+var pluralRules = map[string]func(n int) PluralCategory{
+	// no plural forms, e.g. Chinese, Japanese, Korean
+	"other": func(n int) PluralCategory {
+		return PluralOther
+	},
+	// e.g. English, German, Spanish
+	"one": func(n int) PluralCategory {
+		if n == 1 {
+			return PluralOne
+		}
+		return PluralOther
+	},
+	// e.g. French, Brazilian Portuguese
+	"zero_one": func(n int) PluralCategory {
+		if n == 0 || n == 1 {
+			return PluralOne
+		}
+		return PluralOther
+	},
+	// e.g. Russian, Ukrainian
+	"east_slavic": func(n int) PluralCategory {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return PluralOne
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+	},
+	// e.g. Czech, Slovak
+	"west_slavic": func(n int) PluralCategory {
+		switch {
+		case n == 1:
+			return PluralOne
+		case n >= 2 && n <= 4:
+			return PluralFew
+		default:
+			return PluralOther
+		}
+	},
+	"polish": func(n int) PluralCategory {
+		switch {
+		case n == 1:
+			return PluralOne
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+	},
+	"arabic": func(n int) PluralCategory {
+		switch {
+		case n == 0:
+			return PluralZero
+		case n == 1:
+			return PluralOne
+		case n == 2:
+			return PluralTwo
+		case n%100 >= 3 && n%100 <= 10:
+			return PluralFew
+		case n%100 >= 11:
+			return PluralMany
+		default:
+			return PluralOther
+		}
+	},
+}
+
+// Locale is a bundle of localized names, words and patterns of a language.
+// Bundles are loaded from JSON with LoadLocale, missing entries are inherited from parent bundles, e.g. zh-Hant-HK from zh-Hant, and finally en.
+type Locale struct {
+	// Tag is BCP 47 language tag, e.g. en, zh-Hans
+	Tag string `json:"tag"`
+
+	// Months are names from January
+	Months *NameWidths `json:"months"`
+	// Weekdays are names from Sunday
+	Weekdays   *NameWidths `json:"weekdays"`
+	DayPeriods *DayPeriods `json:"day_periods"`
+	// RelativeDays are words of days relative to today, e.g. -1 is Yesterday
+	RelativeDays map[int]string `json:"relative_days"`
+
+	// Patterns are date patterns with CLDR letters, e.g. "MMM d, y", or message patterns with placeholders, e.g. "{0} all day".
+	// Keys: month, year_month, month_day, year_month_day, time, time_h12, time_h23, time_first_hour, weekday_date, relative_date, range,
+	// all_day, begins, ends, era_year, era_date, calendar_date, last_weekday, this_weekday, next_weekday
+	Patterns map[string]string `json:"patterns"`
+
+	// PluralRule is one of other, one, zero_one, east_slavic, west_slavic, polish and arabic
+	PluralRule string `json:"plural_rule"`
+
+	// Words are localized words by key, e.g. holiday_badge, or plural forms by key and category, e.g. hour_ago.one
+	Words map[string]string `json:"words"`
+
+	// Names are lists of localized names by key, e.g. repeats, moon_phases, solar_terms, zodiacs
+	Names map[string][]string `json:"names"`
+}
+
+var (
+	localesMu  sync.RWMutex
+	locales    = map[string]*Locale{}
+	localeTags []string
+
+	currentLocale atomic.Value
+)
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(tag), "_", "-", -1))
+}
+
+// parentTag returns tag without the last subtag, or en
+func parentTag(tag string) string {
+	if i := strings.LastIndex(tag, "-"); i > 0 {
+		return tag[:i]
+	}
+	if normalizeTag(tag) == "en" {
+		return ""
+	}
+	return "en"
+}
+
+// LoadLocale parses a JSON bundle and registers it. A registered bundle with the same tag is replaced.
+func LoadLocale(data []byte) (*Locale, error) {
+	l := new(Locale)
+	if err := json.Unmarshal(data, l); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+	if err := RegisterLocale(l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// RegisterLocale validates l and registers it. Missing entries are looked up in its parents when they are read,
+// so re-registering a parent takes effect on its children.
+func RegisterLocale(l *Locale) error {
+	if l.Tag == "" {
+		return fmt.Errorf("missing tag")
+	}
+	if l.Months != nil {
+		if err := l.Months.validate("months", 12); err != nil {
+			return err
+		}
+	}
+	if l.Weekdays != nil {
+		if err := l.Weekdays.validate("weekdays", 7); err != nil {
+			return err
+		}
+	}
+	if _, ok := pluralRules[l.PluralRule]; l.PluralRule != "" && !ok {
+		return fmt.Errorf("unknown plural rule %s", l.PluralRule)
+	}
+
+	localesMu.Lock()
+	key := normalizeTag(l.Tag)
+	if _, ok := locales[key]; !ok {
+		localeTags = append(localeTags, key)
+	}
+	locales[key] = l
+	localesMu.Unlock()
+	if c := CurrentLocale(); c != nil && normalizeTag(c.Tag) == key {
+		currentLocale.Store(l)
+	}
+	return nil
+}
+
+func (n *NameWidths) validate(name string, length int) error {
+	for _, l := range [][]string{n.Wide, n.Abbreviated, n.Narrow} {
+		if l != nil && len(l) != length {
+			return fmt.Errorf("expect %d %s instead of %d", length, name, len(l))
+		}
+	}
+	return nil
+}
+
+// parent returns the registered bundle of the nearest parent tag, or nil for en
+func (l *Locale) parent() *Locale {
+	for t := parentTag(l.Tag); t != ""; t = parentTag(t) {
+		if p := findLocale(t); p != nil && p != l {
+			return p
+		}
+	}
+	return nil
+}
+
+// lookup calls f with l and then its parents until f returns true
+func (l *Locale) lookup(f func(l *Locale) bool) {
+	for p := l; p != nil; p = p.parent() {
+		if f(p) {
+			return
+		}
+	}
+}
+
+func findLocale(tag string) *Locale {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	return locales[normalizeTag(tag)]
+}
+
+// GetLocale returns the registered bundle which best matches tag, e.g. zh-Hans-CN and zh matches zh-Hans, zh_HK matches zh-Hant.
+// It returns en if nothing matches.
+func GetLocale(tag string) *Locale {
+	tag = addScript(normalizeTag(tag))
+	lang := tag
+	if i := strings.Index(tag, "-"); i > 0 {
+		lang = tag[:i]
+	}
+	// the tag and its parents in the same language, e.g. en-US matches en
+	for t := tag; t != ""; t = parentTag(t) {
+		if l := findLocale(t); l != nil {
+			return l
+		}
+		if t == lang {
+			break
+		}
+	}
+	// a language without its own bundle matches its first registered script or region, e.g. zh matches zh-Hans
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	for _, t := range localeTags {
+		if strings.HasPrefix(t, lang+"-") {
+			return locales[t]
+		}
+	}
+	return locales["en"]
+}
+
+// regionScripts are scripts implied by regions of languages written in more than one script
+var regionScripts = map[string]string{
+	"zh-cn": "hans",
+	"zh-sg": "hans",
+	"zh-my": "hans",
+	"zh-tw": "hant",
+	"zh-hk": "hant",
+	"zh-mo": "hant",
+}
+
+// addScript inserts the script implied by region, e.g. zh-tw to zh-hant-tw
+func addScript(tag string) string {
+	for prefix, script := range regionScripts {
+		if tag == prefix || strings.HasPrefix(tag, prefix+"-") {
+			lang := prefix[:strings.Index(prefix, "-")]
+			return lang + "-" + script + tag[len(lang):]
+		}
+	}
+	return tag
+}
+
+// CurrentLocale returns the bundle selected by SetLang
+func CurrentLocale() *Locale {
+	l, _ := currentLocale.Load().(*Locale)
+	return l
+}
+
+func setCurrentLocale(l *Locale) {
+	currentLocale.Store(l)
+}
+
+// MonthName returns name of month in [1, 12]
+func (l *Locale) MonthName(month int, width Width) string {
+	return l.names(func(l *Locale) *NameWidths { return l.Months }, width)[(month+11)%12]
+}
+
+// WeekdayName returns name of weekday in [0, 6], 0 is Sunday
+func (l *Locale) WeekdayName(weekday int, width Width) string {
+	return l.names(func(l *Locale) *NameWidths { return l.Weekdays }, width)[weekday%7]
+}
+
+func (l *Locale) names(field func(l *Locale) *NameWidths, width Width) []string {
+	var names []string
+	l.lookup(func(l *Locale) bool {
+		if n := field(l); n != nil {
+			names = n.get(width)
+		}
+		return names != nil
+	})
+	return names
+}
+
+func (l *Locale) dayPeriods() *DayPeriods {
+	var d *DayPeriods
+	l.lookup(func(l *Locale) bool {
+		d = l.DayPeriods
+		return d != nil
+	})
+	if d == nil {
+		return &DayPeriods{}
+	}
+	return d
+}
+
+// word returns localized word of key and whether it's found
+func (l *Locale) word(key string) (s string, ok bool) {
+	l.lookup(func(l *Locale) bool {
+		s, ok = l.Words[key]
+		return ok
+	})
+	return s, ok
+}
+
+// Word returns localized word of key, or key if it's missing
+func (l *Locale) Word(key string) string {
+	if s, ok := l.word(key); ok {
+		return s
+	}
+	return key
+}
+
+// Name returns the i-th name in list key, or empty string if it's missing
+func (l *Locale) Name(key string, i int) string {
+	var v []string
+	l.lookup(func(l *Locale) bool {
+		v = l.Names[key]
+		return v != nil
+	})
+	if i >= 0 && i < len(v) {
+		return v[i]
+	}
+	return ""
+}
+
+// Pattern returns pattern of key
+func (l *Locale) Pattern(key string) (s string) {
+	l.lookup(func(l *Locale) bool {
+		var ok bool
+		s, ok = l.Patterns[key]
+		return ok
+	})
+	return s
+}
+
+// RelativeDay returns word of the day offset days from today, e.g. Tomorrow for 1, or empty string
+func (l *Locale) RelativeDay(offset int) (s string) {
+	l.lookup(func(l *Locale) bool {
+		var ok bool
+		s, ok = l.RelativeDays[offset]
+		return ok
+	})
+	return s
+}
+
+// PluralCategory returns plural category of n
+func (l *Locale) PluralCategory(n int) PluralCategory {
+	if n < 0 {
+		n = -n
+	}
+	var rule string
+	l.lookup(func(l *Locale) bool {
+		rule = l.PluralRule
+		return rule != ""
+	})
+	if f, ok := pluralRules[rule]; ok {
+		return f(n)
+	}
+	return PluralOther
+}
+
+// Plural returns word of key with suffix of n's plural category, e.g. key "day" and n 2 looks up "day.other".
+// Placeholder {0} in the word is replaced with n.
+func (l *Locale) Plural(key string, n int) string {
+	s, ok := l.word(key + "." + string(l.PluralCategory(n)))
+	if !ok {
+		s, _ = l.word(key + ".other")
+	}
+	return expandPattern(s, strconv.Itoa(n))
+}
+
+// localize returns value of current language in m keyed by language tags, falling back to parent tags, the other Chinese script and en
+func localize(m map[string]string) string {
+	tag := CurrentLocale().Tag
+	for t := tag; t != "" && t != "en"; t = parentTag(t) {
+		if s, ok := m[t]; ok {
+			return s
+		}
+	}
+	// Chinese readers prefer the other script to English
+	if strings.HasPrefix(tag, "zh-") {
+		for _, t := range []string{"zh-Hans", "zh-Hant"} {
+			if s, ok := m[t]; ok {
+				return s
+			}
+		}
+	}
+	return m["en"]
+}
+
+// expandPattern replaces placeholders {0}, {1} ... in pattern with args
+func expandPattern(pattern string, args ...string) string {
+	if !strings.Contains(pattern, "{") {
+		return pattern
+	}
+	l := make([]string, 0, len(args)*2)
+	for i, a := range args {
+		l = append(l, "{"+strconv.Itoa(i)+"}", a)
+	}
+	return strings.NewReplacer(l...).Replace(pattern)
+}
+
+// Format formats t with CLDR pattern letters: y, M, L, d, E, a, B, h, K, H, m, s. Text in single quotes is literal.
+func (l *Locale) Format(t time.Time, pattern string) string {
+	return l.format(t, pattern, 0)
+}
+
+// format formats t with pattern, hourPad overrides padding of hour h and K if not 0
+func (l *Locale) format(t time.Time, pattern string, hourPad byte) string {
+	var b strings.Builder
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		if c == '\'' {
+			// '' is a quote, otherwise text is literal until the next single quote
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				b.WriteRune('\'')
+				i += 2
+				continue
+			}
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						b.WriteRune('\'')
+						i++
+						continue
+					}
+					break
+				}
+				b.WriteRune(runes[i])
+			}
+			i++
+			continue
+		}
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			b.WriteRune(c)
+			i++
+			continue
+		}
+		n := 1
+		for i+n < len(runes) && runes[i+n] == c {
+			n++
+		}
+		i += n
+		b.WriteString(l.formatField(t, c, n, hourPad))
+	}
+	return b.String()
+}
+
+func (l *Locale) formatField(t time.Time, c rune, n int, hourPad byte) string {
+	switch c {
+	case 'y':
+		if n == 2 {
+			return fmt.Sprintf("%02d", t.Year()%100)
+		}
+		return padInt(t.Year(), n)
+	case 'M', 'L':
+		switch n {
+		case 1, 2:
+			return padInt(int(t.Month()), n)
+		case 3:
+			return l.MonthName(int(t.Month()), Abbreviated)
+		case 4:
+			return l.MonthName(int(t.Month()), Wide)
+		default:
+			return l.MonthName(int(t.Month()), Narrow)
+		}
+	case 'd':
+		return padInt(t.Day(), n)
+	case 'E':
+		switch {
+		case n <= 3:
+			return l.WeekdayName(int(t.Weekday()), Abbreviated)
+		case n == 4:
+			return l.WeekdayName(int(t.Weekday()), Wide)
+		default:
+			return l.WeekdayName(int(t.Weekday()), Narrow)
+		}
+	case 'a':
+		if t.Hour() < 12 {
+			return l.dayPeriods().AM
+		}
+		return l.dayPeriods().PM
+	case 'B':
+		d := l.dayPeriods()
+		switch {
+		case t.Hour() == 12 && d.Noon != "":
+			return d.Noon
+		case t.Hour() < 12:
+			return d.AM
+		default:
+			return d.PM
+		}
+	case 'h', 'K':
+		// h is 1-12, K is 0-11
+		h := t.Hour() % 12
+		if h == 0 && c == 'h' {
+			h = 12
+		}
+		switch hourPad {
+		case '0':
+			return fmt.Sprintf("%02d", h)
+		case ' ':
+			return fmt.Sprintf("%2d", h)
+		default:
+			return padInt(h, n)
+		}
+	case 'H':
+		return padInt(t.Hour(), n)
+	case 'm':
+		return padInt(t.Minute(), n)
+	case 's':
+		return padInt(t.Second(), n)
+	default:
+		return strings.Repeat(string(c), n)
+	}
+}
+
+func padInt(v, width int) string {
+	return fmt.Sprintf("%0*d", width, v)
+}
+
+func init() {
+	for _, data := range []string{enLocaleData, zhHansLocaleData, zhHantLocaleData, jaLocaleData, koLocaleData, thLocaleData} {
+		if _, err := LoadLocale([]byte(data)); err != nil {
+			panic(fmt.Sprintf("timex: load locale: %v", err))
+		}
+	}
+	setCurrentLocale(GetLocale("en"))
+}
@@ -0,0 +1,639 @@
+package timex
+
+// Built-in locale bundles. They are JSON so that bundles of other languages can be loaded with LoadLocale in the same format.
+
+const enLocaleData = `{
+  "tag": "en",
+  "months": {
+    "wide": ["January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"],
+    "abbreviated": ["Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"],
+    "narrow": ["J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"]
+  },
+  "weekdays": {
+    "wide": ["Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"],
+    "abbreviated": ["Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"],
+    "narrow": ["S", "M", "T", "W", "T", "F", "S"]
+  },
+  "day_periods": {
+    "am": "AM",
+    "pm": "PM",
+    "noon": "",
+    "end_of_day": "Midnight"
+  },
+  "relative_days": {
+    "-1": "Yesterday",
+    "0": "Today",
+    "1": "Tomorrow"
+  },
+  "patterns": {
+    "month": "MMM",
+    "year_month": "MMM y",
+    "month_day": "MMM d",
+    "year_month_day": "MMM d, y",
+    "time": "h:mma",
+    "time_h12": "h:mma",
+    "time_h23": "HH:mm",
+    "time_first_hour": "",
+    "weekday_date": "{0} {1}",
+    "relative_date": "{0} {1}",
+    "range": "{0} - {1}",
+    "all_day": "{0} all day",
+    "begins": "{0} begins",
+    "ends": "{0} ends",
+    "era_year": "{0} {1}",
+    "era_date": "{1}, {0}",
+    "calendar_date": "{0} {1} {2}",
+    "last_weekday": "'last' EEEE",
+    "this_weekday": "EEEE",
+    "next_weekday": "'next' EEEE"
+  },
+  "plural_rule": "one",
+  "words": {
+    "holiday_badge": "Off",
+    "workday_badge": "Work",
+    "minute_ago.one": "{0} minute ago",
+    "minute_later.one": "in {0} minute",
+    "minute_ago.other": "{0} minutes ago",
+    "minute_later.other": "in {0} minutes",
+    "hour_ago.one": "{0} hour ago",
+    "hour_later.one": "in {0} hour",
+    "hour_ago.other": "{0} hours ago",
+    "hour_later.other": "in {0} hours",
+    "week_ago.one": "{0} week ago",
+    "week_later.one": "in {0} week",
+    "week_ago.other": "{0} weeks ago",
+    "week_later.other": "in {0} weeks",
+    "month_ago.one": "{0} month ago",
+    "month_later.one": "in {0} month",
+    "month_ago.other": "{0} months ago",
+    "month_later.other": "in {0} months",
+    "year_ago.one": "{0} year ago",
+    "year_later.one": "in {0} year",
+    "year_ago.other": "{0} years ago",
+    "year_later.other": "in {0} years",
+    "now": "just now",
+    "last_week": "last week",
+    "next_week": "next week",
+    "last_month": "last month",
+    "next_month": "next month",
+    "last_year": "last year",
+    "next_year": "next year",
+    "year_long.one": "{0} year",
+    "year_long.other": "{0} years",
+    "month_long.one": "{0} month",
+    "month_long.other": "{0} months",
+    "week_long.one": "{0} week",
+    "week_long.other": "{0} weeks",
+    "day_long.one": "{0} day",
+    "day_long.other": "{0} days",
+    "hour_long.one": "{0} hour",
+    "hour_long.other": "{0} hours",
+    "minute_long.one": "{0} minute",
+    "minute_long.other": "{0} minutes",
+    "second_long.one": "{0} second",
+    "second_long.other": "{0} seconds",
+    "millisecond_long.one": "{0} millisecond",
+    "millisecond_long.other": "{0} milliseconds",
+    "duration_separator_long": " ",
+    "year_short.other": "{0}y",
+    "month_short.other": "{0}mo",
+    "week_short.other": "{0}w",
+    "day_short.other": "{0}d",
+    "hour_short.other": "{0}h",
+    "minute_short.other": "{0}m",
+    "second_short.other": "{0}s",
+    "millisecond_short.other": "{0}ms",
+    "duration_separator_short": " ",
+    "year_narrow.other": "{0}y",
+    "month_narrow.other": "{0}mo",
+    "week_narrow.other": "{0}w",
+    "day_narrow.other": "{0}d",
+    "hour_narrow.other": "{0}h",
+    "minute_narrow.other": "{0}m",
+    "second_narrow.other": "{0}s",
+    "millisecond_narrow.other": "{0}ms",
+    "duration_separator_narrow": ""
+  },
+  "names": {
+    "repeats": ["Never", "Daily", "Weekly", "Monthly", "Yearly", "Lunar Yearly"],
+    "moon_phases": ["New Moon", "Waxing Crescent", "First Quarter", "Waxing Gibbous", "Full Moon", "Waning Gibbous", "Last Quarter", "Waning Crescent"],
+    "solar_terms": ["Minor Cold", "Major Cold", "Start of Spring", "Rain Water", "Awakening of Insects", "Spring Equinox", "Pure Brightness", "Grain Rain", "Start of Summer", "Grain Full", "Grain in Ear", "Summer Solstice", "Minor Heat", "Major Heat", "Start of Autumn", "End of Heat", "White Dew", "Autumn Equinox", "Cold Dew", "Frost's Descent", "Start of Winter", "Minor Snow", "Major Snow", "Winter Solstice"],
+    "zodiacs": ["Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"],
+    "islamic_months": ["Muharram", "Safar", "Rabi' al-awwal", "Rabi' al-thani", "Jumada al-awwal", "Jumada al-thani", "Rajab", "Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qi'dah", "Dhu al-Hijjah"],
+    "persian_months": ["Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar", "Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"],
+    "hebrew_months": ["Tishrei", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar I", "Adar II", "Nisan", "Iyar", "Sivan", "Tammuz", "Av", "Elul", "Adar"]
+  }
+}`
+
+const zhHansLocaleData = `{
+  "tag": "zh-Hans",
+  "months": {
+    "wide": ["1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"],
+    "abbreviated": ["1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"],
+    "narrow": ["1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"]
+  },
+  "weekdays": {
+    "wide": ["星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"],
+    "abbreviated": ["周日", "周一", "周二", "周三", "周四", "周五", "周六"],
+    "narrow": ["日", "一", "二", "三", "四", "五", "六"]
+  },
+  "day_periods": {
+    "am": "上午",
+    "pm": "下午",
+    "noon": "中午",
+    "end_of_day": "晚上12:00"
+  },
+  "relative_days": {
+    "-1": "昨天",
+    "0": "今天",
+    "1": "明天"
+  },
+  "patterns": {
+    "month": "M月",
+    "year_month": "y年M月",
+    "month_day": "M月d日",
+    "year_month_day": "y年M月d日",
+    "time": "Bh:mm",
+    "time_h12": "Bh:mm",
+    "time_h23": "HH:mm",
+    "time_first_hour": "HH:mm",
+    "all_day": "{0}全天",
+    "begins": "{0} 开始",
+    "ends": "{0} 结束",
+    "era_year": "{0}{1}年",
+    "era_date": "{0}{1}",
+    "calendar_date": "{2}年{1}{0}日",
+    "last_weekday": "上EEE",
+    "this_weekday": "本EEE",
+    "next_weekday": "下EEE"
+  },
+  "plural_rule": "other",
+  "words": {
+    "holiday_badge": "休",
+    "workday_badge": "班",
+    "era_first_year": "元",
+    "minute_ago.other": "{0}分钟前",
+    "minute_later.other": "{0}分钟后",
+    "hour_ago.other": "{0}小时前",
+    "hour_later.other": "{0}小时后",
+    "week_ago.other": "{0}周前",
+    "week_later.other": "{0}周后",
+    "month_ago.other": "{0}个月前",
+    "month_later.other": "{0}个月后",
+    "year_ago.other": "{0}年前",
+    "year_later.other": "{0}年后",
+    "now": "刚刚",
+    "last_week": "上周",
+    "next_week": "下周",
+    "last_month": "上个月",
+    "next_month": "下个月",
+    "last_year": "去年",
+    "next_year": "明年",
+    "year_long.other": "{0}年",
+    "month_long.other": "{0}个月",
+    "week_long.other": "{0}周",
+    "day_long.other": "{0}天",
+    "hour_long.other": "{0}小时",
+    "minute_long.other": "{0}分钟",
+    "second_long.other": "{0}秒",
+    "millisecond_long.other": "{0}毫秒",
+    "duration_separator_long": "",
+    "year_short.other": "{0}年",
+    "month_short.other": "{0}个月",
+    "week_short.other": "{0}周",
+    "day_short.other": "{0}天",
+    "hour_short.other": "{0}小时",
+    "minute_short.other": "{0}分",
+    "second_short.other": "{0}秒",
+    "millisecond_short.other": "{0}毫秒",
+    "duration_separator_short": "",
+    "year_narrow.other": "{0}年",
+    "month_narrow.other": "{0}月",
+    "week_narrow.other": "{0}周",
+    "day_narrow.other": "{0}天",
+    "hour_narrow.other": "{0}时",
+    "minute_narrow.other": "{0}分",
+    "second_narrow.other": "{0}秒",
+    "millisecond_narrow.other": "{0}毫秒",
+    "duration_separator_narrow": ""
+  },
+  "names": {
+    "repeats": ["不重复", "每天", "每周", "每月", "每年", "每年(农历)"],
+    "moon_phases": ["新月", "蛾眉月", "上弦月", "盈凸月", "满月", "亏凸月", "下弦月", "残月"],
+    "solar_terms": ["小寒", "大寒", "立春", "雨水", "惊蛰", "春分", "清明", "谷雨", "立夏", "小满", "芒种", "夏至", "小暑", "大暑", "立秋", "处暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至"],
+    "zodiacs": ["鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊", "猴", "鸡", "狗", "猪"],
+    "islamic_months": ["穆哈兰姆月", "色法尔月", "赖比尔·敖外鲁月", "赖比尔·阿色尼月", "主马达·敖外鲁月", "主马达·阿色尼月", "赖哲卜月", "舍尔邦月", "赖买丹月", "闪瓦鲁月", "都尔喀尔德月", "都尔黑哲月"],
+    "persian_months": ["法尔瓦丁月", "奥尔迪贝赫什特月", "霍尔达德月", "提尔月", "莫尔达德月", "沙赫里瓦尔月", "梅赫尔月", "阿班月", "阿扎尔月", "代伊月", "巴赫曼月", "埃斯凡德月"],
+    "hebrew_months": ["提斯利月", "赫舍汪月", "基斯流月", "提别月", "细罢特月", "亚达一月", "亚达二月", "尼散月", "以珥月", "西弯月", "搭模斯月", "埃波月", "以禄月", "亚达月"]
+  }
+}`
+
+const zhHantLocaleData = `{
+  "tag": "zh-Hant",
+  "months": {
+    "wide": ["1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"],
+    "abbreviated": ["1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"],
+    "narrow": ["1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"]
+  },
+  "weekdays": {
+    "wide": ["星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"],
+    "abbreviated": ["週日", "週一", "週二", "週三", "週四", "週五", "週六"],
+    "narrow": ["日", "一", "二", "三", "四", "五", "六"]
+  },
+  "day_periods": {
+    "am": "上午",
+    "pm": "下午",
+    "noon": "中午",
+    "end_of_day": "午夜12:00"
+  },
+  "relative_days": {
+    "-1": "昨天",
+    "0": "今天",
+    "1": "明天"
+  },
+  "patterns": {
+    "month": "M月",
+    "year_month": "y年M月",
+    "month_day": "M月d日",
+    "year_month_day": "y年M月d日",
+    "time": "Bh:mm",
+    "time_h12": "Bh:mm",
+    "time_h23": "HH:mm",
+    "time_first_hour": "HH:mm",
+    "weekday_date": "{0} {1}",
+    "relative_date": "{0} {1}",
+    "range": "{0} - {1}",
+    "all_day": "{0}全天",
+    "begins": "{0} 開始",
+    "ends": "{0} 結束",
+    "era_year": "{0}{1}年",
+    "era_date": "{0}{1}",
+    "calendar_date": "{2}年{1}{0}日",
+    "last_weekday": "上EEE",
+    "this_weekday": "本EEE",
+    "next_weekday": "下EEE"
+  },
+  "plural_rule": "other",
+  "words": {
+    "holiday_badge": "休",
+    "workday_badge": "班",
+    "era_first_year": "元",
+    "minute_ago.other": "{0}分鐘前",
+    "minute_later.other": "{0}分鐘後",
+    "hour_ago.other": "{0}小時前",
+    "hour_later.other": "{0}小時後",
+    "week_ago.other": "{0}週前",
+    "week_later.other": "{0}週後",
+    "month_ago.other": "{0}個月前",
+    "month_later.other": "{0}個月後",
+    "year_ago.other": "{0}年前",
+    "year_later.other": "{0}年後",
+    "now": "剛剛",
+    "last_week": "上週",
+    "next_week": "下週",
+    "last_month": "上個月",
+    "next_month": "下個月",
+    "last_year": "去年",
+    "next_year": "明年",
+    "year_long.other": "{0}年",
+    "month_long.other": "{0}個月",
+    "week_long.other": "{0}週",
+    "day_long.other": "{0}天",
+    "hour_long.other": "{0}小時",
+    "minute_long.other": "{0}分鐘",
+    "second_long.other": "{0}秒",
+    "millisecond_long.other": "{0}毫秒",
+    "duration_separator_long": "",
+    "year_short.other": "{0}年",
+    "month_short.other": "{0}個月",
+    "week_short.other": "{0}週",
+    "day_short.other": "{0}天",
+    "hour_short.other": "{0}小時",
+    "minute_short.other": "{0}分",
+    "second_short.other": "{0}秒",
+    "millisecond_short.other": "{0}毫秒",
+    "duration_separator_short": "",
+    "year_narrow.other": "{0}年",
+    "month_narrow.other": "{0}月",
+    "week_narrow.other": "{0}週",
+    "day_narrow.other": "{0}天",
+    "hour_narrow.other": "{0}時",
+    "minute_narrow.other": "{0}分",
+    "second_narrow.other": "{0}秒",
+    "millisecond_narrow.other": "{0}毫秒",
+    "duration_separator_narrow": ""
+  },
+  "names": {
+    "repeats": ["不重複", "每天", "每週", "每月", "每年", "每年(農曆)"],
+    "moon_phases": ["新月", "眉月", "上弦月", "盈凸月", "滿月", "虧凸月", "下弦月", "殘月"],
+    "solar_terms": ["小寒", "大寒", "立春", "雨水", "驚蟄", "春分", "清明", "穀雨", "立夏", "小滿", "芒種", "夏至", "小暑", "大暑", "立秋", "處暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至"],
+    "zodiacs": ["鼠", "牛", "虎", "兔", "龍", "蛇", "馬", "羊", "猴", "雞", "狗", "豬"],
+    "islamic_months": ["穆哈蘭姆月", "色法爾月", "賴比爾·敖外魯月", "賴比爾·阿色尼月", "主馬達·敖外魯月", "主馬達·阿色尼月", "賴哲卜月", "舍爾邦月", "賴買丹月", "閃瓦魯月", "都爾喀爾德月", "都爾黑哲月"],
+    "persian_months": ["法爾瓦丁月", "奧爾迪貝赫什特月", "霍爾達德月", "提爾月", "莫爾達德月", "沙赫里瓦爾月", "梅赫爾月", "阿班月", "阿扎爾月", "代伊月", "巴赫曼月", "埃斯凡德月"],
+    "hebrew_months": ["提斯利月", "赫舍汪月", "基斯流月", "提別月", "細罷特月", "亞達一月", "亞達二月", "尼散月", "以珥月", "西彎月", "搭模斯月", "埃波月", "以祿月", "亞達月"]
+  }
+}`
+
+const jaLocaleData = `{
+  "tag": "ja",
+  "months": {
+    "wide": ["1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"],
+    "abbreviated": ["1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"],
+    "narrow": ["1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"]
+  },
+  "weekdays": {
+    "wide": ["日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"],
+    "abbreviated": ["日", "月", "火", "水", "木", "金", "土"],
+    "narrow": ["日", "月", "火", "水", "木", "金", "土"]
+  },
+  "day_periods": {
+    "am": "午前",
+    "pm": "午後",
+    "noon": "",
+    "end_of_day": "24:00"
+  },
+  "relative_days": {
+    "-1": "昨日",
+    "0": "今日",
+    "1": "明日"
+  },
+  "patterns": {
+    "month": "M月",
+    "year_month": "y年M月",
+    "month_day": "M月d日",
+    "year_month_day": "y年M月d日",
+    "time": "aK:mm",
+    "time_h12": "aK:mm",
+    "time_h23": "H:mm",
+    "time_first_hour": "",
+    "weekday_date": "{1}({0})",
+    "relative_date": "{0} {1}",
+    "range": "{0}～{1}",
+    "all_day": "{0} 終日",
+    "begins": "{0} 開始",
+    "ends": "{0} 終了",
+    "era_year": "{0}{1}年",
+    "era_date": "{0}{1}",
+    "calendar_date": "{2}年{1}{0}日",
+    "last_weekday": "先週のEEEE",
+    "this_weekday": "今週のEEEE",
+    "next_weekday": "来週のEEEE"
+  },
+  "plural_rule": "other",
+  "words": {
+    "holiday_badge": "休",
+    "workday_badge": "出",
+    "era_first_year": "元",
+    "minute_ago.other": "{0}分前",
+    "minute_later.other": "{0}分後",
+    "hour_ago.other": "{0}時間前",
+    "hour_later.other": "{0}時間後",
+    "week_ago.other": "{0}週間前",
+    "week_later.other": "{0}週間後",
+    "month_ago.other": "{0}か月前",
+    "month_later.other": "{0}か月後",
+    "year_ago.other": "{0}年前",
+    "year_later.other": "{0}年後",
+    "now": "たった今",
+    "last_week": "先週",
+    "next_week": "来週",
+    "last_month": "先月",
+    "next_month": "来月",
+    "last_year": "昨年",
+    "next_year": "来年",
+    "year_long.other": "{0}年",
+    "month_long.other": "{0}か月",
+    "week_long.other": "{0}週間",
+    "day_long.other": "{0}日",
+    "hour_long.other": "{0}時間",
+    "minute_long.other": "{0}分",
+    "second_long.other": "{0}秒",
+    "millisecond_long.other": "{0}ミリ秒",
+    "duration_separator_long": "",
+    "year_short.other": "{0}年",
+    "month_short.other": "{0}か月",
+    "week_short.other": "{0}週間",
+    "day_short.other": "{0}日",
+    "hour_short.other": "{0}時間",
+    "minute_short.other": "{0}分",
+    "second_short.other": "{0}秒",
+    "millisecond_short.other": "{0}ミリ秒",
+    "duration_separator_short": "",
+    "year_narrow.other": "{0}年",
+    "month_narrow.other": "{0}か月",
+    "week_narrow.other": "{0}週",
+    "day_narrow.other": "{0}日",
+    "hour_narrow.other": "{0}時間",
+    "minute_narrow.other": "{0}分",
+    "second_narrow.other": "{0}秒",
+    "millisecond_narrow.other": "{0}ms",
+    "duration_separator_narrow": ""
+  },
+  "names": {
+    "repeats": ["繰り返さない", "毎日", "毎週", "毎月", "毎年", "毎年(旧暦)"],
+    "moon_phases": ["新月", "三日月", "上弦の月", "十三夜月", "満月", "寝待月", "下弦の月", "有明月"],
+    "solar_terms": ["小寒", "大寒", "立春", "雨水", "啓蟄", "春分", "清明", "穀雨", "立夏", "小満", "芒種", "夏至", "小暑", "大暑", "立秋", "処暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至"],
+    "zodiacs": ["鼠", "牛", "虎", "兎", "竜", "蛇", "馬", "羊", "猿", "鶏", "犬", "猪"]
+  }
+}`
+
+const koLocaleData = `{
+  "tag": "ko",
+  "months": {
+    "wide": ["1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"],
+    "abbreviated": ["1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"],
+    "narrow": ["1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"]
+  },
+  "weekdays": {
+    "wide": ["일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"],
+    "abbreviated": ["일", "월", "화", "수", "목", "금", "토"],
+    "narrow": ["일", "월", "화", "수", "목", "금", "토"]
+  },
+  "day_periods": {
+    "am": "오전",
+    "pm": "오후",
+    "noon": "",
+    "end_of_day": "자정"
+  },
+  "relative_days": {
+    "-1": "어제",
+    "0": "오늘",
+    "1": "내일"
+  },
+  "patterns": {
+    "month": "M월",
+    "year_month": "y년 M월",
+    "month_day": "M월 d일",
+    "year_month_day": "y년 M월 d일",
+    "time": "a h:mm",
+    "time_h12": "a h:mm",
+    "time_h23": "HH:mm",
+    "time_first_hour": "",
+    "weekday_date": "{1} ({0})",
+    "relative_date": "{0} {1}",
+    "range": "{0} ~ {1}",
+    "all_day": "{0} 종일",
+    "begins": "{0} 시작",
+    "ends": "{0} 종료",
+    "era_year": "{0} {1}년",
+    "era_date": "{0} {1}",
+    "calendar_date": "{2}년 {1} {0}일",
+    "last_weekday": "지난주 EEEE",
+    "this_weekday": "이번 주 EEEE",
+    "next_weekday": "다음 주 EEEE"
+  },
+  "plural_rule": "other",
+  "words": {
+    "holiday_badge": "휴",
+    "workday_badge": "근",
+    "minute_ago.other": "{0}분 전",
+    "minute_later.other": "{0}분 후",
+    "hour_ago.other": "{0}시간 전",
+    "hour_later.other": "{0}시간 후",
+    "week_ago.other": "{0}주 전",
+    "week_later.other": "{0}주 후",
+    "month_ago.other": "{0}개월 전",
+    "month_later.other": "{0}개월 후",
+    "year_ago.other": "{0}년 전",
+    "year_later.other": "{0}년 후",
+    "now": "방금",
+    "last_week": "지난주",
+    "next_week": "다음 주",
+    "last_month": "지난달",
+    "next_month": "다음 달",
+    "last_year": "작년",
+    "next_year": "내년",
+    "year_long.other": "{0}년",
+    "month_long.other": "{0}개월",
+    "week_long.other": "{0}주",
+    "day_long.other": "{0}일",
+    "hour_long.other": "{0}시간",
+    "minute_long.other": "{0}분",
+    "second_long.other": "{0}초",
+    "millisecond_long.other": "{0}밀리초",
+    "duration_separator_long": " ",
+    "year_short.other": "{0}년",
+    "month_short.other": "{0}개월",
+    "week_short.other": "{0}주",
+    "day_short.other": "{0}일",
+    "hour_short.other": "{0}시간",
+    "minute_short.other": "{0}분",
+    "second_short.other": "{0}초",
+    "millisecond_short.other": "{0}밀리초",
+    "duration_separator_short": " ",
+    "year_narrow.other": "{0}년",
+    "month_narrow.other": "{0}개월",
+    "week_narrow.other": "{0}주",
+    "day_narrow.other": "{0}일",
+    "hour_narrow.other": "{0}시간",
+    "minute_narrow.other": "{0}분",
+    "second_narrow.other": "{0}초",
+    "millisecond_narrow.other": "{0}ms",
+    "duration_separator_narrow": ""
+  },
+  "names": {
+    "repeats": ["반복 안 함", "매일", "매주", "매월", "매년", "매년(음력)"],
+    "moon_phases": ["삭", "초승달", "상현달", "차가는 달", "보름달", "기우는 달", "하현달", "그믐달"],
+    "solar_terms": ["소한", "대한", "입춘", "우수", "경칩", "춘분", "청명", "곡우", "입하", "소만", "망종", "하지", "소서", "대서", "입추", "처서", "백로", "추분", "한로", "상강", "입동", "소설", "대설", "동지"],
+    "zodiacs": ["쥐", "소", "호랑이", "토끼", "용", "뱀", "말", "양", "원숭이", "닭", "개", "돼지"]
+  }
+}`
+
+const thLocaleData = `{
+  "tag": "th",
+  "months": {
+    "wide": ["มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน", "กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม"],
+    "abbreviated": ["ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."],
+    "narrow": ["ม.ค.", "ก.พ.", "มี.ค.", "เม.ย.", "พ.ค.", "มิ.ย.", "ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค."]
+  },
+  "weekdays": {
+    "wide": ["วันอาทิตย์", "วันจันทร์", "วันอังคาร", "วันพุธ", "วันพฤหัสบดี", "วันศุกร์", "วันเสาร์"],
+    "abbreviated": ["อา.", "จ.", "อ.", "พ.", "พฤ.", "ศ.", "ส."],
+    "narrow": ["อา", "จ", "อ", "พ", "พฤ", "ศ", "ส"]
+  },
+  "day_periods": {
+    "am": "ก่อนเที่ยง",
+    "pm": "หลังเที่ยง",
+    "noon": "",
+    "end_of_day": "เที่ยงคืน"
+  },
+  "relative_days": {
+    "-1": "เมื่อวาน",
+    "0": "วันนี้",
+    "1": "พรุ่งนี้"
+  },
+  "patterns": {
+    "month": "MMM",
+    "year_month": "MMM y",
+    "month_day": "d MMM",
+    "year_month_day": "d MMM y",
+    "time": "HH:mm",
+    "time_h12": "h:mm a",
+    "time_h23": "HH:mm",
+    "time_first_hour": "",
+    "weekday_date": "{0} {1}",
+    "relative_date": "{0} {1}",
+    "range": "{0} - {1}",
+    "all_day": "{0} ทั้งวัน",
+    "begins": "{0} เริ่ม",
+    "ends": "{0} สิ้นสุด",
+    "era_year": "{0} {1}",
+    "era_date": "{1} {0}",
+    "calendar_date": "{0} {1} {2}",
+    "last_weekday": "EEEEที่แล้ว",
+    "this_weekday": "EEEEนี้",
+    "next_weekday": "EEEEหน้า"
+  },
+  "plural_rule": "other",
+  "words": {
+    "holiday_badge": "หยุด",
+    "workday_badge": "ทำงาน",
+    "minute_ago.other": "{0} นาทีที่แล้ว",
+    "minute_later.other": "ในอีก {0} นาที",
+    "hour_ago.other": "{0} ชั่วโมงที่แล้ว",
+    "hour_later.other": "ในอีก {0} ชั่วโมง",
+    "week_ago.other": "{0} สัปดาห์ที่แล้ว",
+    "week_later.other": "ในอีก {0} สัปดาห์",
+    "month_ago.other": "{0} เดือนที่แล้ว",
+    "month_later.other": "ในอีก {0} เดือน",
+    "year_ago.other": "{0} ปีที่แล้ว",
+    "year_later.other": "ในอีก {0} ปี",
+    "now": "เมื่อสักครู่",
+    "last_week": "สัปดาห์ที่แล้ว",
+    "next_week": "สัปดาห์หน้า",
+    "last_month": "เดือนที่แล้ว",
+    "next_month": "เดือนหน้า",
+    "last_year": "ปีที่แล้ว",
+    "next_year": "ปีหน้า",
+    "year_long.other": "{0} ปี",
+    "month_long.other": "{0} เดือน",
+    "week_long.other": "{0} สัปดาห์",
+    "day_long.other": "{0} วัน",
+    "hour_long.other": "{0} ชั่วโมง",
+    "minute_long.other": "{0} นาที",
+    "second_long.other": "{0} วินาที",
+    "millisecond_long.other": "{0} มิลลิวินาที",
+    "duration_separator_long": " ",
+    "year_short.other": "{0} ปี",
+    "month_short.other": "{0} ด.",
+    "week_short.other": "{0} สัปดาห์",
+    "day_short.other": "{0} วัน",
+    "hour_short.other": "{0} ชม.",
+    "minute_short.other": "{0} นาที",
+    "second_short.other": "{0} วิ",
+    "millisecond_short.other": "{0} มิลลิวิ",
+    "duration_separator_short": " ",
+    "year_narrow.other": "{0}ปี",
+    "month_narrow.other": "{0}ด.",
+    "week_narrow.other": "{0}สัปดาห์",
+    "day_narrow.other": "{0}วัน",
+    "hour_narrow.other": "{0}ชม.",
+    "minute_narrow.other": "{0}นาที",
+    "second_narrow.other": "{0}วิ",
+    "millisecond_narrow.other": "{0}มิลลิวิ",
+    "duration_separator_narrow": " "
+  },
+  "names": {
+    "repeats": ["ไม่ซ้ำ", "ทุกวัน", "ทุกสัปดาห์", "ทุกเดือน", "ทุกปี", "ทุกปี (จันทรคติ)"]
+  }
+}`
@@ -0,0 +1,173 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLocale(t *testing.T) {
+	data := `{
+		"tag": "fr",
+		"months": {
+			"wide": ["janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"],
+			"abbreviated": ["janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."]
+		},
+		"relative_days": {"-1": "hier", "0": "aujourd’hui", "1": "demain"},
+		"patterns": {"month_day": "d MMM", "year_month_day": "d MMM y", "time": "HH:mm"},
+		"plural_rule": "zero_one",
+		"words": {"day.one": "{0} jour", "day.other": "{0} jours"}
+	}`
+	l, err := timex.LoadLocale([]byte(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, "octobre", l.MonthName(10, timex.Wide))
+	assert.Equal(t, "oct.", l.MonthName(10, timex.Abbreviated))
+	assert.Equal(t, "o", l.MonthName(10, timex.Narrow))
+	// inherited from en
+	assert.Equal(t, "Monday", l.WeekdayName(1, timex.Wide))
+	assert.Equal(t, "{0} all day", l.Pattern("all_day"))
+	assert.Equal(t, "demain", l.RelativeDay(1))
+
+	assert.Equal(t, "0 jour", l.Plural("day", 0))
+	assert.Equal(t, "1 jour", l.Plural("day", 1))
+	assert.Equal(t, "2 jours", l.Plural("day", 2))
+
+	assert.Equal(t, l, timex.GetLocale("fr-CA"))
+	assert.Equal(t, "en", timex.GetLocale("xx").Tag)
+
+	_, err = timex.LoadLocale([]byte(`{"tag": "xx", "months": {"wide": ["a"]}}`))
+	assert.Error(t, err)
+	_, err = timex.LoadLocale([]byte(`{"tag": "xx", "plural_rule": "unknown"}`))
+	assert.Error(t, err)
+}
+
+func TestRegisterLocale_ParentUpdate(t *testing.T) {
+	parent := &timex.Locale{Tag: "xp", Words: map[string]string{"all_day": "old"}, Patterns: map[string]string{"month": "'p' M"}}
+	require.NoError(t, timex.RegisterLocale(parent))
+	child := &timex.Locale{Tag: "xp-CC", Patterns: map[string]string{"month": "'c' M"}}
+	require.NoError(t, timex.RegisterLocale(child))
+	assert.Equal(t, "old", child.Word("all_day"))
+	assert.Equal(t, "'c' M", child.Pattern("month"))
+
+	parent = &timex.Locale{Tag: "xp", Words: map[string]string{"all_day": "new"}, Months: &timex.NameWidths{
+		Wide: []string{"m1", "m2", "m3", "m4", "m5", "m6", "m7", "m8", "m9", "m10", "m11", "m12"},
+	}}
+	require.NoError(t, timex.RegisterLocale(parent))
+	assert.Equal(t, "new", child.Word("all_day"))
+	assert.Equal(t, "m10", child.MonthName(10, timex.Abbreviated))
+	// missing in both child and re-registered parent, inherited from en
+	assert.Equal(t, "{0} all day", child.Pattern("all_day"))
+}
+
+func TestGetLocale_Region(t *testing.T) {
+	_, err := timex.LoadLocale([]byte(`{"tag": "en-GB", "patterns": {"month_day": "d MMM"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "en-GB", timex.GetLocale("en_GB").Tag)
+	assert.Equal(t, "en", timex.GetLocale("en").Tag)
+	assert.Equal(t, "en", timex.GetLocale("en-US").Tag)
+	assert.Equal(t, "zh-Hans", timex.GetLocale("zh").Tag)
+	assert.Equal(t, "en", timex.GetLocale("xx-YY").Tag)
+}
+
+func TestLocale_Format(t *testing.T) {
+	tm := time.Date(2026, 10, 19, 13, 5, 9, 0, time.UTC)
+	en := timex.GetLocale("en")
+	assert.Equal(t, "Oct 19, 2026", en.Format(tm, en.Pattern("year_month_day")))
+	assert.Equal(t, "Monday, October 19 at 1:05:09 PM", en.Format(tm, "EEEE, MMMM d 'at' h:mm:ss a"))
+	assert.Equal(t, "1 o'clock", en.Format(tm, "h 'o''clock'"))
+	assert.Equal(t, "2026-10-19 13:05", en.Format(tm, "yyyy-MM-dd HH:mm"))
+	assert.Equal(t, "M 19", en.Format(tm, "EEEEE d"))
+
+	zh := timex.GetLocale("zh-Hans-CN")
+	assert.Equal(t, "zh-Hans", zh.Tag)
+	assert.Equal(t, "2026年10月19日 星期一", zh.Format(tm, "y年M月d日 EEEE"))
+	assert.Equal(t, "下午1:05", zh.Format(tm, zh.Pattern("time")))
+	assert.Equal(t, "中午12:05", zh.Format(tm.Add(-time.Hour), zh.Pattern("time")))
+}
+
+func TestLocale_PluralCategory(t *testing.T) {
+	tests := []struct {
+		tag  string
+		n    int
+		want timex.PluralCategory
+	}{
+		{"en", 1, timex.PluralOne},
+		{"en", 0, timex.PluralOther},
+		{"en", 21, timex.PluralOther},
+		{"zh-Hans", 1, timex.PluralOther},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, timex.GetLocale(test.tag).PluralCategory(test.n), test.tag, test.n)
+	}
+}
+
+func TestSetLang(t *testing.T) {
+	defer timex.SetLang("en")
+	d := timex.NewDate(2020, 10, 19)
+
+	timex.SetLang("zh_CN")
+	assert.True(t, timex.IsSimplifiedChinese())
+	assert.Equal(t, "2020年10月19日", d.PrettyText())
+	assert.Equal(t, "周一", timex.GetWeekdaySymbol(1))
+	assert.Equal(t, "每周", timex.Weekly.String())
+	assert.Equal(t, "2020年10月", timex.NewMonth(2020, 10).RelativeText())
+	assert.Equal(t, "今天", timex.Today().ShortText())
+	assert.Equal(t, "晚上12:00", timex.Today().EndT().TimeText())
+
+	timex.SetLang("en-US")
+	assert.False(t, timex.IsSimplifiedChinese())
+	assert.Equal(t, "Oct 19, 2020", d.PrettyText())
+	assert.Equal(t, "Mon", timex.GetWeekdaySymbol(1))
+	assert.Equal(t, "Today", timex.Today().ShortText())
+}
+
+func TestSetLang_CJK(t *testing.T) {
+	defer timex.SetLang("en")
+	tests := []struct {
+		lang     string
+		tag      string
+		pretty   string
+		weekday  string
+		time     string
+		midnight string
+		repeat   string
+	}{
+		{"zh-Hant-TW", "zh-Hant", "2020年10月19日", "週一", "下午1:05", "00:30", "每週"},
+		{"zh_HK", "zh-Hant", "2020年10月19日", "週一", "下午1:05", "00:30", "每週"},
+		{"zh-TW", "zh-Hant", "2020年10月19日", "週一", "下午1:05", "00:30", "每週"},
+		{"zh-CN", "zh-Hans", "2020年10月19日", "周一", "下午1:05", "00:30", "每周"},
+		{"zh", "zh-Hans", "2020年10月19日", "周一", "下午1:05", "00:30", "每周"},
+		{"ja-JP", "ja", "2020年10月19日", "月", "午後1:05", "午前0:30", "毎週"},
+		{"ko", "ko", "2020년 10월 19일", "월", "오후 1:05", "오전 12:30", "매주"},
+	}
+	d := timex.NewDate(2020, 10, 19)
+	afternoon := d.BeginT().AddTime(13, 5)
+	midnight := d.BeginT().AddTime(0, 30)
+	for _, test := range tests {
+		timex.SetLang(test.lang)
+		assert.Equal(t, test.tag, timex.CurrentLocale().Tag, test.lang)
+		assert.Equal(t, test.pretty, d.PrettyText(), test.lang)
+		assert.Equal(t, test.weekday, timex.GetWeekdaySymbol(1), test.lang)
+		assert.Equal(t, test.time, afternoon.TimeText(), test.lang)
+		assert.Equal(t, test.midnight, midnight.TimeText(), test.lang)
+		assert.Equal(t, test.repeat, timex.Weekly.String(), test.lang)
+	}
+
+	timex.SetLang("zh-Hant")
+	assert.True(t, timex.IsTraditionalChinese())
+	assert.Equal(t, "2020年10月", timex.NewMonth(2020, 10).RelativeText())
+	assert.Equal(t, "今天", timex.Today().ShortText())
+
+	timex.SetLang("ja")
+	assert.Equal(t, "今日", timex.Today().ShortText())
+	assert.Equal(t, "2020年10月19日(月)", d.ShortText())
+	assert.Equal(t, "令和元年5月1日", timex.NewDate(2019, 5, 1).EraText(timex.Japanese))
+
+	timex.SetLang("ko")
+	assert.Equal(t, "내일", timex.Today().Add(0, 0, 1).ShortText())
+	assert.Equal(t, "2020년 10월 19일 (월)", d.LongText())
+}
@@ -0,0 +1,55 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocation(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	d := timex.NewDateIn(2026, 10, 19, tokyo)
+	assert.Equal(t, tokyo, d.Location())
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, tokyo), d.Begin())
+	assert.Equal(t, ny, d.InLocation(ny).Begin().Location())
+	assert.True(t, d.InLocation(ny).Equals(d))
+
+	now := time.Now()
+	assert.True(t, timex.TodayIn(tokyo).Equals(timex.DateWithTime(now.In(tokyo))))
+	assert.True(t, timex.TodayIn(ny).IsToday())
+
+	tm := time.Date(2026, 10, 19, 23, 30, 0, 0, ny)
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, ny), timex.BeginOfDay(tm))
+	assert.Equal(t, time.Date(2026, 10, 20, 0, 0, 0, 0, tokyo), timex.BeginOfDayIn(tm, tokyo))
+	assert.Equal(t, time.Date(2026, 10, 20, 23, 59, 59, 999999999, tokyo), timex.EndOfDayIn(tm, tokyo))
+
+	r := timex.NewMonth(2026, 11).Range(ny)
+	assert.Equal(t, time.Date(2026, 11, 1, 0, 0, 0, 0, ny), r.Begin())
+	assert.Equal(t, time.Date(2026, 12, 1, 0, 0, 0, 0, ny), r.End())
+	// November 2026 has DST end
+	assert.Equal(t, 30*timex.Day+time.Hour, r.Duration())
+	assert.Equal(t, ny, r.Begin().Location())
+	assert.Equal(t, 30, len(r.Dates()))
+
+	r = timex.NewRange(tm, tm.Add(time.Hour))
+	assert.Equal(t, ny, r.Begin().Location())
+	assert.Equal(t, ny, r.End().Location())
+
+	g := timex.NewMonth(2026, 10).Grid(&timex.GridOptions{Location: tokyo})
+	assert.Equal(t, tokyo, g[0][4].Date.Location())
+}
+
+func TestRange_Scan_KeepsZone(t *testing.T) {
+	var r timex.Range
+	require.NoError(t, r.Scan("[2026-10-19 10:00:00+09, 2026-10-19 11:30:00+09]"))
+	_, offset := r.Begin().Zone()
+	assert.Equal(t, 9*3600, offset)
+	assert.Equal(t, 10, r.Begin().Hour())
+	assert.Equal(t, 11, r.End().Hour())
+}
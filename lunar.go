@@ -0,0 +1,244 @@
+package timex
+
+import (
+	"fmt"
+)
+
+// lunarInfo encodes lunar years 1900-2100.
+// Bits 0-3: leap month, 0 means no leap month. Bits 4-15: month 12 to month 1, set if the month has 30 days.
+// Bit 16: set if the leap month has 30 days.
+var lunarInfo = [...]int{
+	0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2, // 1900-1909
+	0x04ae0, 0x0a5b6, 0x0a4d0, 0x0d250, 0x1d255, 0x0b540, 0x0d6a0, 0x0ada2, 0x095b0, 0x14977, // 1910-1919
+	0x04970, 0x0a4b0, 0x0b4b5, 0x06a50, 0x06d40, 0x1ab54, 0x02b60, 0x09570, 0x052f2, 0x04970, // 1920-1929
+	0x06566, 0x0d4a0, 0x0ea50, 0x16a95, 0x05ad0, 0x02b60, 0x186e3, 0x092e0, 0x1c8d7, 0x0c950, // 1930-1939
+	0x0d4a0, 0x1d8a6, 0x0b550, 0x056a0, 0x1a5b4, 0x025d0, 0x092d0, 0x0d2b2, 0x0a950, 0x0b557, // 1940-1949
+	0x06ca0, 0x0b550, 0x15355, 0x04da0, 0x0a5b0, 0x14573, 0x052b0, 0x0a9a8, 0x0e950, 0x06aa0, // 1950-1959
+	0x0aea6, 0x0ab50, 0x04b60, 0x0aae4, 0x0a570, 0x05260, 0x0f263, 0x0d950, 0x05b57, 0x056a0, // 1960-1969
+	0x096d0, 0x04dd5, 0x04ad0, 0x0a4d0, 0x0d4d4, 0x0d250, 0x0d558, 0x0b540, 0x0b6a0, 0x195a6, // 1970-1979
+	0x095b0, 0x049b0, 0x0a974, 0x0a4b0, 0x0b27a, 0x06a50, 0x06d40, 0x0af46, 0x0ab60, 0x09570, // 1980-1989
+	0x04af5, 0x04970, 0x064b0, 0x074a3, 0x0ea50, 0x06b58, 0x05ac0, 0x0ab60, 0x096d5, 0x092e0, // 1990-1999
+	0x0c960, 0x0d954, 0x0d4a0, 0x0da50, 0x07552, 0x056a0, 0x0abb7, 0x025d0, 0x092d0, 0x0cab5, // 2000-2009
+	0x0a950, 0x0b4a0, 0x0baa4, 0x0ad50, 0x055d9, 0x04ba0, 0x0a5b0, 0x15176, 0x052b0, 0x0a930, // 2010-2019
+	0x07954, 0x06aa0, 0x0ad50, 0x05b52, 0x04b60, 0x0a6e6, 0x0a4e0, 0x0d260, 0x0ea65, 0x0d530, // 2020-2029
+	0x05aa0, 0x076a3, 0x096d0, 0x04afb, 0x04ad0, 0x0a4d0, 0x1d0b6, 0x0d250, 0x0d520, 0x0dd45, // 2030-2039
+	0x0b5a0, 0x056d0, 0x055b2, 0x049b0, 0x0a577, 0x0a4b0, 0x0aa50, 0x1b255, 0x06d20, 0x0ada0, // 2040-2049
+	0x14b63, 0x09370, 0x049f8, 0x04970, 0x064b0, 0x168a6, 0x0ea50, 0x06b20, 0x1a6c4, 0x0aae0, // 2050-2059
+	0x092e0, 0x0d2e3, 0x0c960, 0x0d557, 0x0d4a0, 0x0da50, 0x05d55, 0x056a0, 0x0a6d0, 0x055d4, // 2060-2069
+	0x052d0, 0x0a9b8, 0x0a950, 0x0b4a0, 0x0b6a6, 0x0ad50, 0x055a0, 0x0aba4, 0x0a5b0, 0x052b0, // 2070-2079
+	0x0b273, 0x06930, 0x07337, 0x06aa0, 0x0ad50, 0x14b55, 0x04b60, 0x0a570, 0x054e4, 0x0d160, // 2080-2089
+	0x0e968, 0x0d520, 0x0daa0, 0x16aa6, 0x056d0, 0x04ae0, 0x0a9d4, 0x0a2d0, 0x0d150, 0x0f252, // 2090-2099
+	0x0d520, // 2100
+}
+
+const (
+	MinLunarYear = 1900
+	MaxLunarYear = 2100
+)
+
+// lunarEpoch is 1900-01-31, the first day of lunar year 1900
+var lunarEpoch = &Date{year: 1900, month: 1, day: 31}
+
+var (
+	lunarMonthNames  = []string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+	lunarDayPrefixes = []string{"初", "十", "廿", "三"}
+	chineseDigits    = []string{"十", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	heavenlyStems    = []string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	earthlyBranches  = []string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+)
+
+// LunarLeapMonth returns leap month of lunar year, 0 means no leap month
+func LunarLeapMonth(year int) int {
+	checkLunarYear(year)
+	return lunarInfo[year-MinLunarYear] & 0xf
+}
+
+// NumOfLunarMonthDays returns 29 or 30, or 0 if the leap month doesn't exist
+func NumOfLunarMonthDays(year, month int, leap bool) int {
+	checkLunarYear(year)
+	if month < 1 || month > 12 {
+		panic(fmt.Sprintf("timex: invalid lunar month %d", month))
+	}
+	info := lunarInfo[year-MinLunarYear]
+	if leap {
+		if info&0xf != month {
+			return 0
+		}
+		if info&0x10000 != 0 {
+			return 30
+		}
+		return 29
+	}
+	if info&(0x10000>>uint(month)) != 0 {
+		return 30
+	}
+	return 29
+}
+
+// NumOfLunarYearDays returns number of days in lunar year
+func NumOfLunarYearDays(year int) int {
+	n := 0
+	for m := 1; m <= 12; m++ {
+		n += NumOfLunarMonthDays(year, m, false)
+	}
+	if leap := LunarLeapMonth(year); leap > 0 {
+		n += NumOfLunarMonthDays(year, leap, true)
+	}
+	return n
+}
+
+func checkLunarYear(year int) {
+	if year < MinLunarYear || year > MaxLunarYear {
+		panic(fmt.Sprintf("timex: lunar year %d is out of [%d, %d]", year, MinLunarYear, MaxLunarYear))
+	}
+}
+
+// LunarDate is a date in Chinese lunisolar calendar (农历)
+type LunarDate struct {
+	Year        int  `json:"year"`
+	Month       int  `json:"month"`
+	Day         int  `json:"day"`
+	IsLeapMonth bool `json:"is_leap_month"`
+}
+
+// NewLunarDate validates and creates a lunar date
+func NewLunarDate(year, month, day int, leap bool) (*LunarDate, error) {
+	if year < MinLunarYear || year > MaxLunarYear {
+		return nil, fmt.Errorf("year %d is out of [%d, %d]", year, MinLunarYear, MaxLunarYear)
+	}
+	if month < 1 || month > 12 {
+		return nil, fmt.Errorf("invalid month %d", month)
+	}
+	n := NumOfLunarMonthDays(year, month, leap)
+	if n == 0 {
+		return nil, fmt.Errorf("no leap month %d in %d", month, year)
+	}
+	if day < 1 || day > n {
+		return nil, fmt.Errorf("invalid day %d, month %d has %d days", day, month, n)
+	}
+	return &LunarDate{
+		Year:        year,
+		Month:       month,
+		Day:         day,
+		IsLeapMonth: leap,
+	}, nil
+}
+
+// Lunar converts d into lunar date. It returns nil if d is out of lunar years 1900-2100.
+func (d *Date) Lunar() *LunarDate {
+	offset := daysBetween(lunarEpoch, d)
+	if offset < 0 {
+		return nil
+	}
+	year := MinLunarYear
+	for ; year <= MaxLunarYear; year++ {
+		n := NumOfLunarYearDays(year)
+		if offset < n {
+			break
+		}
+		offset -= n
+	}
+	if year > MaxLunarYear {
+		return nil
+	}
+	leap := LunarLeapMonth(year)
+	for m := 1; m <= 12; m++ {
+		n := NumOfLunarMonthDays(year, m, false)
+		if offset < n {
+			return &LunarDate{Year: year, Month: m, Day: offset + 1}
+		}
+		offset -= n
+		if m == leap {
+			n = NumOfLunarMonthDays(year, m, true)
+			if offset < n {
+				return &LunarDate{Year: year, Month: m, Day: offset + 1, IsLeapMonth: true}
+			}
+			offset -= n
+		}
+	}
+	panic("timex: invalid lunar info")
+}
+
+// Date converts lunar date into Gregorian date
+func (l *LunarDate) Date() *Date {
+	days := 0
+	for y := MinLunarYear; y < l.Year; y++ {
+		days += NumOfLunarYearDays(y)
+	}
+	leap := LunarLeapMonth(l.Year)
+	for m := 1; m < l.Month; m++ {
+		days += NumOfLunarMonthDays(l.Year, m, false)
+		if m == leap {
+			days += NumOfLunarMonthDays(l.Year, m, true)
+		}
+	}
+	if l.IsLeapMonth {
+		days += NumOfLunarMonthDays(l.Year, l.Month, false)
+	}
+	days += l.Day - 1
+	return NewDate(lunarEpoch.year, lunarEpoch.month, lunarEpoch.day+days)
+}
+
+// MonthText returns month name, e.g. 正月, 闰四月, 腊月
+func (l *LunarDate) MonthText() string {
+	s := lunarMonthNames[l.Month-1] + "月"
+	if l.IsLeapMonth {
+		return "闰" + s
+	}
+	return s
+}
+
+// DayText returns day name, e.g. 初一, 十五, 廿三
+func (l *LunarDate) DayText() string {
+	switch l.Day {
+	case 10:
+		return "初十"
+	case 20:
+		return "二十"
+	case 30:
+		return "三十"
+	default:
+		return lunarDayPrefixes[l.Day/10] + chineseDigits[l.Day%10]
+	}
+}
+
+// ShortText returns month name on the first day of month, otherwise day name. It's usually displayed under dates in month view.
+func (l *LunarDate) ShortText() string {
+	if l.Day == 1 {
+		return l.MonthText()
+	}
+	return l.DayText()
+}
+
+// YearText returns sexagenary year name, e.g. 甲辰
+func (l *LunarDate) YearText() string {
+	return SexagenaryYear(l.Year)
+}
+
+// Zodiac returns zodiac animal of the year in current language
+func (l *LunarDate) Zodiac() string {
+	return CurrentLocale().Name("zodiacs", mod(l.Year-4, 12))
+}
+
+// String returns full Chinese text, e.g. 甲辰年腊月廿三
+func (l *LunarDate) String() string {
+	return l.YearText() + "年" + l.MonthText() + l.DayText()
+}
+
+func (l *LunarDate) Equals(lunar *LunarDate) bool {
+	return *l == *lunar
+}
+
+// SexagenaryYear returns sexagenary (干支) name of year, e.g. 2024 is 甲辰
+func SexagenaryYear(year int) string {
+	i := mod(year-4, 60)
+	return heavenlyStems[i%10] + earthlyBranches[i%12]
+}
+
+func mod(a, b int) int {
+	r := a % b
+	if r < 0 {
+		r += b
+	}
+	return r
+}
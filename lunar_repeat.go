@@ -0,0 +1,142 @@
+package timex
+
+// LeapMonthRule decides the occurrence of a leap month anchored recurrence in years without that leap month
+type LeapMonthRule int
+
+const (
+	// LeapMonthFallback repeats in the regular month of the same number
+	LeapMonthFallback LeapMonthRule = iota
+	// LeapMonthSkip repeats only in years with that leap month
+	LeapMonthSkip
+)
+
+// MissingDayRule decides the occurrence of day 30 in years when the month has 29 days
+type MissingDayRule int
+
+const (
+	// MissingDayLastDay repeats on the last day of month
+	MissingDayLastDay MissingDayRule = iota
+	// MissingDayNextDay repeats on the first day of next month
+	MissingDayNextDay
+	// MissingDaySkip doesn't repeat in that year
+	MissingDaySkip
+)
+
+// LunarRecurrence repeats yearly on a lunar month and day, e.g. birthdays and traditional festivals.
+// It implements HolidayRule.
+type LunarRecurrence struct {
+	Month       int
+	Day         int
+	IsLeapMonth bool
+	LeapMonth   LeapMonthRule
+	MissingDay  MissingDayRule
+}
+
+var _ HolidayRule = (*LunarRecurrence)(nil)
+
+var (
+	SpringFestival      = &LunarRecurrence{Month: 1, Day: 1}
+	LanternFestival     = &LunarRecurrence{Month: 1, Day: 15}
+	DragonBoatFestival  = &LunarRecurrence{Month: 5, Day: 5}
+	QixiFestival        = &LunarRecurrence{Month: 7, Day: 7}
+	GhostFestival       = &LunarRecurrence{Month: 7, Day: 15}
+	MidAutumnFestival   = &LunarRecurrence{Month: 8, Day: 15}
+	DoubleNinthFestival = &LunarRecurrence{Month: 9, Day: 9}
+	LabaFestival        = &LunarRecurrence{Month: 12, Day: 8}
+	ChineseNewYearsEve  = &LunarRecurrence{Month: 12, Day: 30}
+)
+
+// NewLunarRecurrence creates a recurrence anchored to lunar date l with default rules
+func NewLunarRecurrence(l *LunarDate) *LunarRecurrence {
+	return &LunarRecurrence{
+		Month:       l.Month,
+		Day:         l.Day,
+		IsLeapMonth: l.IsLeapMonth,
+	}
+}
+
+// DateInLunarYear returns the occurrence in lunar year, or nil if it doesn't occur by rules
+func (r *LunarRecurrence) DateInLunarYear(year int) *Date {
+	if year < MinLunarYear || year > MaxLunarYear {
+		return nil
+	}
+	leap := false
+	if r.IsLeapMonth {
+		leap = LunarLeapMonth(year) == r.Month
+		if !leap && r.LeapMonth == LeapMonthSkip {
+			return nil
+		}
+	}
+	n := NumOfLunarMonthDays(year, r.Month, leap)
+	if r.Day <= n {
+		return (&LunarDate{Year: year, Month: r.Month, Day: r.Day, IsLeapMonth: leap}).Date()
+	}
+	last := (&LunarDate{Year: year, Month: r.Month, Day: n, IsLeapMonth: leap}).Date()
+	switch r.MissingDay {
+	case MissingDayNextDay:
+		return last.Add(0, 0, 1)
+	case MissingDaySkip:
+		return nil
+	default:
+		return last
+	}
+}
+
+// DateIn returns the first occurrence in Gregorian year
+func (r *LunarRecurrence) DateIn(year int) *Date {
+	for y := year - 1; y <= year; y++ {
+		if d := r.DateInLunarYear(y); d != nil && d.year == year {
+			return d
+		}
+	}
+	return nil
+}
+
+// Next returns the first occurrence after d, or nil if there's none before MaxLunarYear ends
+func (r *LunarRecurrence) Next(d *Date) *Date {
+	l := d.Lunar()
+	if l == nil {
+		if daysBetween(lunarEpoch, d) >= 0 {
+			return nil
+		}
+		l = &LunarDate{Year: MinLunarYear - 1}
+	}
+	for y := l.Year; y <= MaxLunarYear; y++ {
+		if v := r.DateInLunarYear(y); v != nil && daysBetween(d, v) > 0 {
+			return v
+		}
+	}
+	return nil
+}
+
+// Prev returns the last occurrence before d, or nil if there's none after MinLunarYear begins
+func (r *LunarRecurrence) Prev(d *Date) *Date {
+	l := d.Lunar()
+	if l == nil {
+		if daysBetween(lunarEpoch, d) < 0 {
+			return nil
+		}
+		l = &LunarDate{Year: MaxLunarYear + 1}
+	}
+	for y := l.Year; y >= MinLunarYear; y-- {
+		if v := r.DateInLunarYear(y); v != nil && daysBetween(v, d) > 0 {
+			return v
+		}
+	}
+	return nil
+}
+
+// NewLunarFestivalProvider returns traditional Chinese festivals
+func NewLunarFestivalProvider() *RuleHolidayProvider {
+	return NewRuleHolidayProvider(
+		&HolidayDef{Names: names("Chinese New Year's Eve", "除夕"), Rule: ChineseNewYearsEve},
+		&HolidayDef{Names: names("Spring Festival", "春节"), Rule: SpringFestival},
+		&HolidayDef{Names: names("Lantern Festival", "元宵节"), Rule: LanternFestival},
+		&HolidayDef{Names: names("Dragon Boat Festival", "端午节"), Rule: DragonBoatFestival},
+		&HolidayDef{Names: names("Qixi Festival", "七夕节"), Rule: QixiFestival},
+		&HolidayDef{Names: names("Ghost Festival", "中元节"), Rule: GhostFestival},
+		&HolidayDef{Names: names("Mid-Autumn Festival", "中秋节"), Rule: MidAutumnFestival},
+		&HolidayDef{Names: names("Double Ninth Festival", "重阳节"), Rule: DoubleNinthFestival},
+		&HolidayDef{Names: names("Laba Festival", "腊八节"), Rule: LabaFestival},
+	)
+}
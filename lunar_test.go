@@ -0,0 +1,133 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate_Lunar(t *testing.T) {
+	springFestivals := map[int]*timex.Date{
+		1900: timex.NewDate(1900, 1, 31),
+		1950: timex.NewDate(1950, 2, 17),
+		2000: timex.NewDate(2000, 2, 5),
+		2020: timex.NewDate(2020, 1, 25),
+		2024: timex.NewDate(2024, 2, 10),
+		2025: timex.NewDate(2025, 1, 29),
+		2026: timex.NewDate(2026, 2, 17),
+		2033: timex.NewDate(2033, 1, 31),
+		2100: timex.NewDate(2100, 2, 9),
+	}
+	for year, d := range springFestivals {
+		l := d.Lunar()
+		require.NotNil(t, l)
+		assert.Equal(t, timex.LunarDate{Year: year, Month: 1, Day: 1}, *l, d.String())
+		assert.True(t, l.Date().Equals(d))
+		assert.Equal(t, "正月初一", l.MonthText()+l.DayText())
+		if year > timex.MinLunarYear {
+			prev := d.Add(0, 0, -1).Lunar()
+			assert.Equal(t, year-1, prev.Year)
+			assert.Equal(t, 12, prev.Month)
+		}
+	}
+
+	// 2023 has leap 2nd month from 2023-03-22
+	l := timex.NewDate(2023, 3, 22).Lunar()
+	assert.Equal(t, timex.LunarDate{Year: 2023, Month: 2, Day: 1, IsLeapMonth: true}, *l)
+	assert.Equal(t, "闰二月", l.ShortText())
+	assert.Equal(t, 2, timex.LunarLeapMonth(2023))
+	assert.Equal(t, 0, timex.LunarLeapMonth(2024))
+	assert.Equal(t, 0, timex.NumOfLunarMonthDays(2024, 4, true))
+
+	// 2026-10-17 is 九月初八
+	l = timex.NewDate(2026, 10, 17).Lunar()
+	assert.Equal(t, "丙午年九月初八", l.String())
+	assert.Equal(t, "Horse", l.Zodiac())
+	timex.SetLang("ja")
+	assert.Equal(t, "馬", l.Zodiac())
+	timex.SetLang("en")
+
+	assert.Nil(t, timex.NewDate(1900, 1, 30).Lunar())
+	assert.Nil(t, timex.NewDate(2101, 1, 29).Lunar())
+	assert.NotNil(t, timex.NewDate(2101, 1, 28).Lunar())
+}
+
+func TestLunarDate_Text(t *testing.T) {
+	l, err := timex.NewLunarDate(2024, 12, 23, false)
+	require.NoError(t, err)
+	assert.Equal(t, "腊月廿三", l.MonthText()+l.DayText())
+	assert.Equal(t, "甲辰", l.YearText())
+	assert.True(t, l.Date().Equals(timex.NewDate(2025, 1, 22)))
+	assert.Equal(t, "廿三", l.ShortText())
+
+	l, err = timex.NewLunarDate(2020, 4, 1, true)
+	require.NoError(t, err)
+	assert.Equal(t, "闰四月初一", l.MonthText()+l.DayText())
+	assert.True(t, l.Date().Equals(timex.NewDate(2020, 5, 23)))
+
+	for _, d := range []int{10, 20, 30, 11, 21} {
+		l.Day = d
+		assert.NotEmpty(t, l.DayText())
+	}
+	l.Day = 30
+	assert.Equal(t, "三十", l.DayText())
+	l.Day = 20
+	assert.Equal(t, "二十", l.DayText())
+
+	_, err = timex.NewLunarDate(2024, 4, 1, true)
+	assert.Error(t, err)
+	_, err = timex.NewLunarDate(2024, 1, 31, false)
+	assert.Error(t, err)
+	assert.Equal(t, "甲子", timex.SexagenaryYear(1984))
+	assert.Equal(t, "癸亥", timex.SexagenaryYear(1983))
+}
+
+func TestLunarDate_RoundTrip(t *testing.T) {
+	for d := timex.NewDate(1900, 1, 31); d.Year() < 2101; d = d.Add(0, 0, 13) {
+		l := d.Lunar()
+		require.NotNil(t, l, d.String())
+		require.True(t, l.Date().Equals(d), d.String())
+	}
+}
+
+func TestLunarRecurrence(t *testing.T) {
+	assert.True(t, timex.MidAutumnFestival.DateIn(2026).Equals(timex.NewDate(2026, 9, 25)))
+	assert.True(t, timex.DragonBoatFestival.DateIn(2026).Equals(timex.NewDate(2026, 6, 19)))
+	assert.True(t, timex.DoubleNinthFestival.DateIn(2026).Equals(timex.NewDate(2026, 10, 18)))
+	// 2025 has 29 days in 12th month
+	assert.True(t, timex.ChineseNewYearsEve.DateIn(2026).Equals(timex.NewDate(2026, 2, 16)))
+
+	// born on 2020 闰四月初一
+	birth := &timex.LunarRecurrence{Month: 4, Day: 1, IsLeapMonth: true}
+	d := timex.NewDate(2020, 5, 23)
+	next := birth.Next(d)
+	assert.True(t, next.Equals((&timex.LunarDate{Year: 2021, Month: 4, Day: 1}).Date()))
+	birth.LeapMonth = timex.LeapMonthSkip
+	next = birth.Next(d)
+	assert.True(t, next.Lunar().IsLeapMonth)
+	assert.Equal(t, 4, next.Lunar().Month)
+	assert.True(t, birth.Prev(next).Equals(d))
+
+	day30 := &timex.LunarRecurrence{Month: 12, Day: 30, MissingDay: timex.MissingDaySkip}
+	assert.Nil(t, day30.DateInLunarYear(2025))
+	day30.MissingDay = timex.MissingDayNextDay
+	assert.True(t, day30.DateInLunarYear(2025).Equals(timex.NewDate(2026, 2, 17)))
+}
+
+func TestDate_NextRepeatLunarYearly(t *testing.T) {
+	d := timex.NewDate(2025, 10, 6)
+	assert.Equal(t, "八月十五", d.Lunar().MonthText()+d.Lunar().DayText())
+	assert.True(t, d.NextRepeat(timex.LunarYearly).Equals(timex.NewDate(2026, 9, 25)))
+
+	r := d.Range()
+	next := r.NextRepeat(timex.LunarYearly)
+	assert.True(t, next.FirstDay().Equals(timex.NewDate(2026, 9, 25)))
+	assert.True(t, next.IsAllDay())
+	assert.True(t, next.PrevRepeat(timex.LunarYearly).Equals(r))
+
+	p := timex.NewLunarFestivalProvider()
+	assert.Equal(t, "Qixi Festival", p.HolidayName(timex.NewDate(2026, 8, 19)))
+	assert.True(t, timex.NewCNHolidayProvider().IsHoliday(timex.NewDate(2026, 2, 19)))
+}
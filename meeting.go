@@ -0,0 +1,168 @@
+package timex
+
+import (
+	"sort"
+	"time"
+)
+
+// Participant is a meeting participant with working hours in the participant's time zone
+type Participant struct {
+	Name  string
+	Hours *BusinessHours
+}
+
+// NewParticipant creates a participant working [begin, end) on Monday to Friday in loc, e.g. 9*time.Hour, 17*time.Hour
+func NewParticipant(name string, loc *time.Location, begin, end time.Duration) *Participant {
+	return &Participant{
+		Name:  name,
+		Hours: NewWorkdayBusinessHours(loc, begin, end, nil),
+	}
+}
+
+// Location returns the participant's time zone
+func (p *Participant) Location() *time.Location {
+	return p.Hours.Location()
+}
+
+// ParticipantTime is a range in a participant's wall clock
+type ParticipantTime struct {
+	Participant *Participant
+	Begin       *Time
+	End         *Time
+	// InWorkingHours is true if the whole range is in the participant's working hours
+	InWorkingHours bool
+}
+
+// MeetingSlot is a range in which the same participants are in working hours
+type MeetingSlot struct {
+	Range       *Range
+	Available   []*Participant
+	Unavailable []*Participant
+}
+
+// MeetingPlanner finds ranges which suit participants in different time zones
+type MeetingPlanner struct {
+	participants []*Participant
+}
+
+func NewMeetingPlanner(participants ...*Participant) *MeetingPlanner {
+	if len(participants) == 0 {
+		panic("timex: no participants")
+	}
+	return &MeetingPlanner{
+		participants: participants,
+	}
+}
+
+func (p *MeetingPlanner) Participants() []*Participant {
+	return p.participants
+}
+
+// Slots splits r into ranges by availability of participants, ranges where nobody is available are omitted.
+// Slots are in chronological order, use RankSlots to sort them by preference.
+func (p *MeetingPlanner) Slots(r *Range) []*MeetingSlot {
+	clips := make([][]*Range, len(p.participants))
+	points := []time.Time{r.begin, r.end}
+	for i, v := range p.participants {
+		clips[i] = v.Hours.Clip(r)
+		for _, c := range clips[i] {
+			points = append(points, c.begin, c.end)
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Before(points[j])
+	})
+
+	var l []*MeetingSlot
+	for i := 1; i < len(points); i++ {
+		begin, end := points[i-1], points[i]
+		if !begin.Before(end) {
+			continue
+		}
+		s := &MeetingSlot{Range: NewRange(begin.In(r.begin.Location()), end.In(r.begin.Location()))}
+		for j, v := range p.participants {
+			if containsTime(clips[j], begin) {
+				s.Available = append(s.Available, v)
+			} else {
+				s.Unavailable = append(s.Unavailable, v)
+			}
+		}
+		if len(s.Available) == 0 {
+			continue
+		}
+		// merge with previous slot if availability is the same
+		if n := len(l); n > 0 && l[n-1].Range.end.Equal(begin) && sameParticipants(l[n-1].Available, s.Available) {
+			l[n-1].Range.end = s.Range.end
+			continue
+		}
+		l = append(l, s)
+	}
+	return l
+}
+
+// Overlaps returns ranges in r when all participants are in working hours, e.g. r is a day or a week
+func (p *MeetingPlanner) Overlaps(r *Range) []*Range {
+	var l []*Range
+	for _, s := range p.Slots(r) {
+		if len(s.Unavailable) == 0 {
+			l = append(l, s.Range)
+		}
+	}
+	return l
+}
+
+// RankedSlots returns slots at least minDuration long, sorted by number of available participants, then duration, then begin time
+func (p *MeetingPlanner) RankedSlots(r *Range, minDuration time.Duration) []*MeetingSlot {
+	var l []*MeetingSlot
+	for _, s := range p.Slots(r) {
+		if s.Range.Duration() >= minDuration {
+			l = append(l, s)
+		}
+	}
+	sort.SliceStable(l, func(i, j int) bool {
+		if a, b := len(l[i].Available), len(l[j].Available); a != b {
+			return a > b
+		}
+		if a, b := l[i].Range.Duration(), l[j].Range.Duration(); a != b {
+			return a > b
+		}
+		return l[i].Range.begin.Before(l[j].Range.begin)
+	})
+	return l
+}
+
+// LocalTimes returns r in each participant's wall clock, e.g. to display with Time.TimeText
+func (p *MeetingPlanner) LocalTimes(r *Range) []*ParticipantTime {
+	l := make([]*ParticipantTime, len(p.participants))
+	for i, v := range p.participants {
+		loc := v.Location()
+		l[i] = &ParticipantTime{
+			Participant:    v,
+			Begin:          &Time{t: r.begin.In(loc)},
+			End:            &Time{t: r.end.In(loc)},
+			InWorkingHours: v.Hours.Duration(r) == r.Duration(),
+		}
+	}
+	return l
+}
+
+func containsTime(l []*Range, t time.Time) bool {
+	for _, r := range l {
+		if r.ContainsTime(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameParticipants(a, b []*Participant) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeetingPlanner_Overlaps(t *testing.T) {
+	london := loadLocation(t, "Europe/London")
+	ny := loadLocation(t, "America/New_York")
+	p := timex.NewMeetingPlanner(
+		timex.NewParticipant("Alice", london, 9*time.Hour, 17*time.Hour),
+		timex.NewParticipant("Bob", ny, 9*time.Hour, 17*time.Hour),
+	)
+
+	week := func(y, m, d int) *timex.Range {
+		begin := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+		return timex.NewRange(begin, begin.AddDate(0, 0, 7))
+	}
+	// before DST
+	l := p.Overlaps(week(2026, 3, 2))
+	require.Equal(t, 5, len(l))
+	assert.Equal(t, time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC), l[0].Begin())
+	assert.Equal(t, 3*time.Hour, l[0].Duration())
+	// New York is in DST from 2026-03-08 while London is not until 2026-03-29
+	l = p.Overlaps(week(2026, 3, 9))
+	require.Equal(t, 5, len(l))
+	assert.Equal(t, time.Date(2026, 3, 9, 13, 0, 0, 0, time.UTC), l[0].Begin())
+	assert.Equal(t, 4*time.Hour, l[0].Duration())
+	l = p.Overlaps(week(2026, 3, 30))
+	require.Equal(t, 5, len(l))
+	assert.Equal(t, 3*time.Hour, l[4].Duration())
+	// London's DST begins on 2026-03-29 within the week
+	l = p.Overlaps(week(2026, 3, 25))
+	require.Equal(t, 5, len(l))
+	assert.Equal(t, 4*time.Hour, l[2].Duration())
+	assert.Equal(t, 3*time.Hour, l[3].Duration())
+
+	lt := p.LocalTimes(l[0])
+	require.Equal(t, 2, len(lt))
+	assert.Equal(t, "1:00PM", lt[0].Begin.TimeText())
+	assert.Equal(t, "5:00PM", lt[0].End.TimeText())
+	assert.Equal(t, "9:00AM", lt[1].Begin.TimeText())
+	assert.True(t, lt[1].InWorkingHours)
+}
+
+func TestMeetingPlanner_RankedSlots(t *testing.T) {
+	p := timex.NewMeetingPlanner(
+		timex.NewParticipant("Tokyo", loadLocation(t, "Asia/Tokyo"), 9*time.Hour, 18*time.Hour),
+		timex.NewParticipant("Berlin", loadLocation(t, "Europe/Berlin"), 9*time.Hour, 17*time.Hour),
+		timex.NewParticipant("New York", loadLocation(t, "America/New_York"), 9*time.Hour, 17*time.Hour),
+	)
+	day := timex.NewRange(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC))
+	assert.Empty(t, p.Overlaps(day))
+
+	l := p.RankedSlots(day, 30*time.Minute)
+	require.NotEmpty(t, l)
+	// Tokyo 16:00-18:00 JST overlaps Berlin 09:00-11:00 CEST, and Berlin 15:00-17:00 overlaps New York 09:00-11:00 EDT
+	assert.Equal(t, 2, len(l[0].Available))
+	assert.Equal(t, 1, len(l[0].Unavailable))
+	assert.Equal(t, 2*time.Hour, l[0].Range.Duration())
+	assert.Equal(t, time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC), l[0].Range.Begin())
+	assert.Equal(t, "New York", l[0].Unavailable[0].Name)
+	assert.Equal(t, 2, len(l[1].Available))
+	assert.Equal(t, time.Date(2026, 10, 20, 13, 0, 0, 0, time.UTC), l[1].Range.Begin())
+	for _, s := range l[2:] {
+		assert.Equal(t, 1, len(s.Available))
+	}
+	assert.False(t, p.LocalTimes(l[0].Range)[2].InWorkingHours)
+}
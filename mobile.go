@@ -1,7 +1,6 @@
 package timex
 
 import (
-	"fmt"
 	"time"
 )
 
@@ -116,52 +115,19 @@ func (t *Time) RoundHour() *Time {
 }
 
 func (t *Time) TimeText() string {
-	return t.timeText("%d:%02d")
+	return defaultFormatter.TimeText(t.t)
 }
 
 func (t *Time) TimeTextWithZero() string {
-	return t.timeText("%02d:%02d")
+	return defaultFormatter.TimeTextWithZero(t.t)
 }
 
 func (t *Time) TimeTextWithSpace() string {
-	return t.timeText("%2d:%02d")
-}
-
-func (t *Time) timeText(format string) string {
-	if IsSimplifiedChinese() {
-		if t.IsEndOfDay() {
-			return "晚上12:00"
-		}
-		h, m := t.Hour(), t.Minute()
-		switch {
-		case h == 0:
-			return fmt.Sprintf("00:%02d", m)
-		case h < 12:
-			return fmt.Sprintf("上午"+format, h, m)
-		case h == 12:
-			return fmt.Sprintf("中午"+format, h, m)
-		default:
-			return fmt.Sprintf("下午"+format, h-12, m)
-		}
-	}
-	if t.IsEndOfDay() {
-		return "Midnight"
-	}
-	h, m := t.Hour(), t.Minute()
-	switch {
-	case h == 0:
-		return fmt.Sprintf(format+"AM", 12, m)
-	case h < 12:
-		return fmt.Sprintf(format+"AM", h, m)
-	case h == 12:
-		return fmt.Sprintf(format+"PM", h, m)
-	default:
-		return fmt.Sprintf(format+"PM", h-12, m)
-	}
+	return defaultFormatter.TimeTextWithSpace(t.t)
 }
 
 func (t *Time) RelativeDateTimeText() string {
-	return fmt.Sprintf("%s %s", t.Date().ShortText(), t.TimeTextWithZero())
+	return defaultFormatter.RelativeDateTimeText(t.t)
 }
 
 func NewRangeT(begin, end *Time) *Range {
@@ -12,9 +12,9 @@ type Month struct {
 	Year  int `json:"year"`
 	Month int `json:"month"`
 
-	// [4,7]*7 matrix
-	mu       sync.Mutex
-	calendar [][7]*Date
+	// first weekday => [4,6]*7 matrix
+	mu        sync.Mutex
+	calendars map[int][][7]*Date
 }
 
 func NewMonth(y, m int) *Month {
@@ -34,11 +34,29 @@ func NewMonth(y, m int) *Month {
 }
 
 func (m *Month) Begin() time.Time {
-	return time.Date(m.Year, time.Month(m.Month), 1, 0, 0, 0, 0, time.Local)
+	return m.BeginIn(time.Local)
 }
 
 func (m *Month) End() time.Time {
-	return time.Date(m.Year, time.Month(m.Month+1), 0, 23, 59, 59, 999999999, time.Local)
+	return m.EndIn(time.Local)
+}
+
+// BeginIn returns the first instant of the month in time zone loc
+func (m *Month) BeginIn(loc *time.Location) time.Time {
+	return NewDateIn(m.Year, m.Month, 1, loc).Begin()
+}
+
+// EndIn returns the last nanosecond of the month in time zone loc
+func (m *Month) EndIn(loc *time.Location) time.Time {
+	return m.Add(0, 1).BeginIn(loc).Add(-time.Nanosecond)
+}
+
+// Range returns [begin, end) of the month in time zone loc, nil loc means time.Local
+func (m *Month) Range(loc *time.Location) *Range {
+	if loc == nil {
+		loc = time.Local
+	}
+	return NewRange(m.BeginIn(loc), m.Add(0, 1).BeginIn(loc))
 }
 
 func (m *Month) NumOfDays() int {
@@ -83,10 +101,12 @@ func (m *Month) Add(years, months int) *Month {
 }
 
 func (m *Month) NumOfWeeks() int {
-	if m.calendar == nil {
-		_ = m.GetCalendarDate(1, 1)
-	}
-	return len(m.calendar)
+	return m.NumOfWeeksWithFirstWeekday(0)
+}
+
+// NumOfWeeksWithFirstWeekday returns number of rows in the calendar grid whose weeks start on firstWeekday [0, 6], 0 is Sunday
+func (m *Month) NumOfWeeksWithFirstWeekday(firstWeekday int) int {
+	return len(m.calendar(firstWeekday))
 }
 
 func (m *Month) Before(mo *Month) bool {
@@ -102,40 +122,50 @@ func (m *Month) After(mo *Month) bool {
 
 // GetCalendarDate: week is [1, NumOfWeeks], day is [1, 7]
 func (m *Month) GetCalendarDate(week, day int) *Date {
+	return m.GetCalendarDateWithFirstWeekday(0, week, day)
+}
+
+// GetCalendarDateWithFirstWeekday: firstWeekday is [0, 6], week is [1, NumOfWeeksWithFirstWeekday], day is [1, 7]
+func (m *Month) GetCalendarDateWithFirstWeekday(firstWeekday, week, day int) *Date {
 	week -= 1
 	day -= 1
-	if m.calendar == nil {
-		m.mu.Lock()
-		if m.calendar == nil {
-			first := int(m.Begin().Weekday())
-			numOfDays := m.NumOfDays()
-			days := numOfDays - (7 - first)
-			lines := 1 + days/7
-			if days%7 != 0 {
-				lines += 1
-			}
-			m.calendar = make([][7]*Date, lines)
-			last := first + numOfDays - 1
-			for i := 0; i < lines; i++ {
-				for j := 0; j < 7; j++ {
-					offset := i*7 + j
-					if offset < first || offset > last {
-						m.calendar[i][j] = nil
-					} else {
-						m.calendar[i][j] = NewDate(m.Year, m.Month, offset-first+1)
-					}
-				}
-			}
-		}
-		m.mu.Unlock()
-	}
-	if week < 0 || week >= len(m.calendar) {
+	calendar := m.calendar(firstWeekday)
+	if week < 0 || week >= len(calendar) {
 		return nil
 	}
 	if day < 0 || day >= 7 {
 		return nil
 	}
-	return m.calendar[week][day]
+	return calendar[week][day]
+}
+
+func (m *Month) calendar(firstWeekday int) [][7]*Date {
+	if firstWeekday < 0 || firstWeekday > 6 {
+		panic(fmt.Sprintf("timex: invalid weekday %d", firstWeekday))
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok := m.calendars[firstWeekday]; ok {
+		return c
+	}
+	first := (int(m.Begin().Weekday()) - firstWeekday + 7) % 7
+	numOfDays := m.NumOfDays()
+	lines := (first + numOfDays + 6) / 7
+	c := make([][7]*Date, lines)
+	last := first + numOfDays - 1
+	for i := 0; i < lines; i++ {
+		for j := 0; j < 7; j++ {
+			offset := i*7 + j
+			if offset >= first && offset <= last {
+				c[i][j] = NewDate(m.Year, m.Month, offset-first+1)
+			}
+		}
+	}
+	if m.calendars == nil {
+		m.calendars = make(map[int][][7]*Date)
+	}
+	m.calendars[firstWeekday] = c
+	return c
 }
 
 func (m *Month) Date(day int) *Date {
@@ -146,17 +176,9 @@ func (m *Month) String() string {
 	return fmt.Sprintf("%d-%d", m.Year, m.Month)
 }
 
+// RelativeText returns month name, with year if it's not this year
 func (m *Month) RelativeText() string {
-	if m.Year == time.Now().Year() {
-		if IsSimplifiedChinese() {
-			return fmt.Sprintf("%d月", m.Month)
-		}
-		return time.Month(m.Month).String()[:3]
-	}
-	if IsSimplifiedChinese() {
-		return fmt.Sprintf("%d年%d月", m.Year, m.Month)
-	}
-	return fmt.Sprintf("%s %d", time.Month(m.Month).String()[:3], m.Year)
+	return defaultFormatter.MonthText(m)
 }
 
 func CurrentMonth() *Month {
@@ -1,6 +1,7 @@
 package timex_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/gopub/timex"
@@ -69,3 +70,29 @@ func TestMonth_GetCalendarDate(t *testing.T) {
 		assert.True(t, date.Equals(te.Date))
 	}
 }
+
+func TestMonth_GetCalendarDateWithFirstWeekday(t *testing.T) {
+	m := timex.NewMonth(2020, 8)
+	assert.Equal(t, 6, m.NumOfWeeks())
+	assert.Equal(t, 6, m.NumOfWeeksWithFirstWeekday(1))
+	m = timex.NewMonth(2015, 2)
+	assert.Equal(t, 4, m.NumOfWeeks())
+	assert.Equal(t, 5, m.NumOfWeeksWithFirstWeekday(1))
+
+	m = timex.NewMonth(2020, 9)
+	// 2020-9-1 is Tuesday
+	assert.True(t, m.GetCalendarDateWithFirstWeekday(1, 1, 2).Equals(timex.NewDate(2020, 9, 1)))
+	assert.Nil(t, m.GetCalendarDateWithFirstWeekday(1, 1, 1))
+	assert.True(t, m.GetCalendarDateWithFirstWeekday(1, 3, 7).Equals(timex.NewDate(2020, 9, 20)))
+	assert.True(t, m.GetCalendarDate(4, 1).Equals(timex.NewDate(2020, 9, 20)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 7; i++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			assert.NotEmpty(t, m.NumOfWeeksWithFirstWeekday(w))
+		}(i)
+	}
+	wg.Wait()
+}
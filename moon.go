@@ -0,0 +1,151 @@
+package timex
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// MoonPhase is one of eight phases of the moon, each spans 45 degrees of elongation centered on the principal phase
+type MoonPhase int
+
+const (
+	NewMoon MoonPhase = iota
+	WaxingCrescent
+	FirstQuarter
+	WaxingGibbous
+	FullMoon
+	WaningGibbous
+	LastQuarter
+	WaningCrescent
+)
+
+// String returns name in current language
+func (p MoonPhase) String() string {
+	if p < NewMoon || p > WaningCrescent {
+		return fmt.Sprint(int(p))
+	}
+	return CurrentLocale().Name("moon_phases", int(p))
+}
+
+// MoonInfo is the moon's phase at an instant
+type MoonInfo struct {
+	Phase MoonPhase `json:"phase"`
+	// Illumination is fraction of the disk illuminated, from 0 to 1
+	Illumination float64 `json:"illumination"`
+	// Elongation is the moon's phase in degrees, from 0 at new moon to 180 at full moon and back to 360
+	Elongation float64 `json:"elongation"`
+}
+
+// MoonInfoAt returns phase and illumination of the moon at t
+func MoonInfoAt(t time.Time) *MoonInfo {
+	// Meeus chapter 48, low precision phase angle
+	tc := (universalToDynamical(julianDay(t)) - julianDayJ2000) / 36525
+	d := normalizeDegrees(297.8501921+445267.1114034*tc) * degToRad
+	m := normalizeDegrees(357.5291092+35999.0502909*tc) * degToRad
+	mp := normalizeDegrees(134.9633964+477198.8675055*tc) * degToRad
+	i := 180 - d/degToRad - 6.289*math.Sin(mp) + 2.100*math.Sin(m) - 1.274*math.Sin(2*d-mp) -
+		0.658*math.Sin(2*d) - 0.214*math.Sin(2*mp) - 0.110*math.Sin(d)
+	e := normalizeDegrees(180 - i)
+	return &MoonInfo{
+		Phase:        MoonPhase(int(normalizeDegrees(e+22.5)/45) % 8),
+		Illumination: (1 + math.Cos(i*degToRad)) / 2,
+		Elongation:   e,
+	}
+}
+
+// MoonInfo returns the moon's phase at noon of d in time zone loc, nil loc means time.Local
+func (d *Date) MoonInfo(loc *time.Location) *MoonInfo {
+	if loc == nil {
+		loc = time.Local
+	}
+	return MoonInfoAt(time.Date(d.year, time.Month(d.month), d.day, 12, 0, 0, 0, loc))
+}
+
+// meanLunation returns k of the lunation nearest to t, 0 is the new moon on 2000-01-06
+func meanLunation(t time.Time) float64 {
+	return math.Floor((julianDay(t) - 2451550.09766) / 29.530588861)
+}
+
+// moonPhaseTime returns instant of new moon if k is integer, or full moon if k ends with .5. Meeus chapter 49.
+func moonPhaseTime(k float64) time.Time {
+	t := k / 1236.85
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+	jde := 2451550.09766 + 29.530588861*k + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := (2.5534 + 29.10535670*k - 0.0000014*t2 - 0.00000011*t3) * degToRad
+	mp := (201.5643 + 385.81693528*k + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4) * degToRad
+	f := (160.7108 + 390.67050284*k - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4) * degToRad
+	omega := (124.7746 - 1.56375588*k + 0.0020672*t2 + 0.00000215*t3) * degToRad
+
+	c0, c1, c2 := -0.40720, 0.17241, 0.01608
+	c3, c4 := 0.01039, 0.00739
+	if k-math.Floor(k) != 0 {
+		c0, c1, c2 = -0.40614, 0.17302, 0.01614
+		c3, c4 = 0.01043, 0.00734
+	}
+	jde += c0*math.Sin(mp) + c1*e*math.Sin(m) + c2*math.Sin(2*mp) + c3*math.Sin(2*f) +
+		c4*e*math.Sin(mp-m) - 0.00515*e*math.Sin(mp+m) + 0.00209*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) - 0.00057*math.Sin(mp+2*f) + 0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) + 0.00042*e*math.Sin(m+2*f) + 0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) - 0.00017*math.Sin(omega) - 0.00007*math.Sin(mp+2*m) +
+		0.00004*math.Sin(2*mp-2*f) + 0.00004*math.Sin(3*m) + 0.00003*math.Sin(mp+m-2*f) +
+		0.00003*math.Sin(2*mp+2*f) - 0.00003*math.Sin(mp+m+2*f) + 0.00003*math.Sin(mp-m+2*f) -
+		0.00002*math.Sin(mp-m-2*f) - 0.00002*math.Sin(3*mp+m) + 0.00002*math.Sin(4*mp)
+
+	// planetary arguments
+	jde += 0.000325 * math.Sin((299.77+0.107408*k-0.009173*t2)*degToRad)
+	for _, a := range [][3]float64{
+		{0.000165, 251.88, 0.016321},
+		{0.000164, 251.83, 26.651886},
+		{0.000126, 349.42, 36.412478},
+		{0.000110, 84.66, 18.206239},
+		{0.000062, 141.74, 53.303771},
+		{0.000060, 207.14, 2.453732},
+		{0.000056, 154.84, 7.306860},
+		{0.000047, 34.52, 27.261239},
+		{0.000042, 207.19, 0.121824},
+		{0.000040, 291.34, 1.844379},
+		{0.000037, 161.72, 24.198154},
+		{0.000035, 239.56, 25.513099},
+		{0.000023, 331.55, 3.592518},
+	} {
+		jde += a[0] * math.Sin((a[1]+a[2]*k)*degToRad)
+	}
+	return timeOfJulianDay(dynamicalToUniversal(jde))
+}
+
+// moonPhaseTimes returns instants of new moons (offset 0) or full moons (offset 0.5) in [begin, end)
+func moonPhaseTimes(begin, end time.Time, offset float64) []time.Time {
+	var l []time.Time
+	for k := meanLunation(begin) - 1 + offset; ; k++ {
+		t := moonPhaseTime(k)
+		if !t.Before(end) {
+			break
+		}
+		if !t.Before(begin) {
+			l = append(l, t.In(begin.Location()))
+		}
+	}
+	return l
+}
+
+// NewMoons returns instants of new moons in the range
+func (r *Range) NewMoons() []time.Time {
+	return moonPhaseTimes(r.begin, r.end, 0)
+}
+
+// FullMoons returns instants of full moons in the range
+func (r *Range) FullMoons() []time.Time {
+	return moonPhaseTimes(r.begin, r.end, 0.5)
+}
+
+// NewMoons returns instants of new moons in the month
+func (m *Month) NewMoons() []time.Time {
+	return moonPhaseTimes(m.Begin(), m.Add(0, 1).Begin(), 0)
+}
+
+// FullMoons returns instants of full moons in the month
+func (m *Month) FullMoons() []time.Time {
+	return moonPhaseTimes(m.Begin(), m.Add(0, 1).Begin(), 0.5)
+}
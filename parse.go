@@ -0,0 +1,281 @@
+package timex
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ParseOptions configures ParseDate and ParseTime
+type ParseOptions struct {
+	// Locale resolves ambiguous day/month order, e.g. 10/11/2026 is Oct 11 for en-US and Nov 10 for en-GB.
+	// Empty locale uses the locale selected by SetLang.
+	Locale string
+
+	// Location is used when text has no zone info. Default is time.Local
+	Location *time.Location
+}
+
+// ParseError is returned when no layout matches the text
+type ParseError struct {
+	Text string
+	// Locale is the locale which selected day/month order of layouts, or empty if the order doesn't matter
+	Locale string
+	// Shape describes the layouts tried, e.g. digits with AM/PM, or empty if all layouts are tried
+	Shape string
+}
+
+func (e *ParseError) Error() string {
+	if e.Shape == "" {
+		return fmt.Sprintf("timex: cannot parse %q", e.Text)
+	}
+	return fmt.Sprintf("timex: cannot parse %q: no layout of shape %s for locale %s", e.Text, e.Shape, e.Locale)
+}
+
+var monthFirstRegions = []string{"us", "ph", "fm", "mh", "pw", "as", "gu", "mp", "pr", "um", "vi"}
+
+func isMonthFirst(locale string) bool {
+	l := strings.ToLower(strings.Replace(locale, "_", "-", -1))
+	if l == "" || l == "en" {
+		return true
+	}
+	fields := strings.Split(l, "-")
+	for _, f := range fields[1:] {
+		for _, r := range monthFirstRegions {
+			if f == r {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var (
+	unambiguousDateLayouts = []string{
+		"2006-1-2",
+		"2006/1/2",
+		"2006.1.2",
+		"20060102",
+		"2006年1月2日",
+		"Jan 2, 2006",
+		"Jan 2 2006",
+		"January 2, 2006",
+		"January 2 2006",
+		"Mon, Jan 2, 2006",
+		"Monday, January 2, 2006",
+		"2 Jan 2006",
+		"2 January 2006",
+		"Mon, 2 Jan 2006",
+		"Monday, 2 January 2006",
+		"2-Jan-2006",
+	}
+	monthFirstDateLayouts = []string{
+		"1/2/2006",
+		"1-2-2006",
+		"1.2.2006",
+	}
+	dayFirstDateLayouts = []string{
+		"2/1/2006",
+		"2-1-2006",
+		"2.1.2006",
+	}
+	timeSuffixLayouts = []string{
+		" 15:04",
+		" 15:04:05",
+		" 15:04:05.999999999",
+		" 3:04 PM",
+		" 3:04PM",
+		" 3:04:05 PM",
+		" 3:04:05PM",
+		" 3 PM",
+		" 3PM",
+		"T15:04",
+		"T15:04:05",
+		"T15:04:05.999999999",
+		"15:04",
+		"15:04:05",
+		"3:04 PM",
+	}
+	zoneSuffixLayouts = []string{
+		"",
+		"Z07:00",
+		" Z07:00",
+		" -0700",
+		" MST",
+	}
+	fullTimeLayouts = []string{
+		time.RFC3339Nano,
+		time.RFC1123,
+		time.RFC1123Z,
+		time.RFC850,
+		time.RFC822,
+		time.RFC822Z,
+		time.ANSIC,
+		time.UnixDate,
+		time.RubyDate,
+	}
+)
+
+// layoutSet holds layouts of a day/month order, which are built once
+type layoutSet struct {
+	dates []string
+	// times are grouped by timeLayoutGroup so that text is only tried with layouts of the same shape
+	times [8][]string
+}
+
+var (
+	monthFirstLayouts = newLayoutSet(true)
+	dayFirstLayouts   = newLayoutSet(false)
+
+	dateTimeSeparatorRegexp = regexp.MustCompile(`\dT\d`)
+	meridiemSuffixRegexp    = regexp.MustCompile(`\d ?[AP]M\b`)
+)
+
+func newLayoutSet(monthFirst bool) *layoutSet {
+	s := &layoutSet{}
+	s.dates = append(s.dates, unambiguousDateLayouts...)
+	if monthFirst {
+		s.dates = append(s.dates, monthFirstDateLayouts...)
+		s.dates = append(s.dates, dayFirstDateLayouts...)
+	} else {
+		s.dates = append(s.dates, dayFirstDateLayouts...)
+		s.dates = append(s.dates, monthFirstDateLayouts...)
+	}
+	add := func(layout string) {
+		g := timeLayoutGroup(strings.Replace(layout, "PM", "4PM", -1))
+		s.times[g] = append(s.times[g], layout)
+	}
+	for _, l := range fullTimeLayouts {
+		add(l)
+	}
+	for _, d := range s.dates {
+		for _, t := range timeSuffixLayouts {
+			if d == "20060102" && t[0] != 'T' {
+				continue
+			}
+			for _, z := range zoneSuffixLayouts {
+				add(d + t + z)
+			}
+		}
+	}
+	return s
+}
+
+// timeLayoutGroup returns group of normalized text or layout by whether it has T between date and time, has AM/PM
+// and begins with a digit
+func timeLayoutGroup(s string) int {
+	g := 0
+	if s != "" && s[0] >= '0' && s[0] <= '9' {
+		g |= 4
+	}
+	if dateTimeSeparatorRegexp.MatchString(s) {
+		g |= 1
+	}
+	if meridiemSuffixRegexp.MatchString(s) {
+		g |= 2
+	}
+	return g
+}
+
+// timeLayoutShape describes group g of timeLayoutGroup, e.g. digits with T
+func timeLayoutShape(g int) string {
+	s := "words"
+	if g&4 != 0 {
+		s = "digits"
+	}
+	if g&1 != 0 {
+		s += " with T"
+	}
+	if g&2 != 0 {
+		s += " with AM/PM"
+	}
+	return s
+}
+
+// parseLocale returns locale of opts, or tag of the locale selected by SetLang
+func parseLocale(opts *ParseOptions) string {
+	if opts.Locale == "" {
+		return CurrentLocale().Tag
+	}
+	return opts.Locale
+}
+
+func layoutsOf(locale string) *layoutSet {
+	if isMonthFirst(locale) {
+		return monthFirstLayouts
+	}
+	return dayFirstLayouts
+}
+
+var (
+	spacesRegexp     = regexp.MustCompile(`\s+`)
+	ordinalRegexp    = regexp.MustCompile(`(?i)\b(\d{1,2})(st|nd|rd|th)\b`)
+	meridiemRegexp   = regexp.MustCompile(`(?i)(\d ?)([ap])\.?m\.?(\s|$)`)
+	zhMeridiemRegexp = regexp.MustCompile(`(凌晨|早上|上午|中午|下午|晚上)\s*(\d{1,2}(:\d{2}){0,2})`)
+	zhTimeRegexp     = regexp.MustCompile(`(\d{1,2})[时點点](\d{1,2})分?`)
+)
+
+func normalizeTimeText(s string) string {
+	s = strings.TrimSpace(spacesRegexp.ReplaceAllString(s, " "))
+	s = ordinalRegexp.ReplaceAllString(s, "$1")
+	s = meridiemRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		m := meridiemRegexp.FindStringSubmatch(v)
+		return m[1] + strings.ToUpper(m[2]) + "M" + m[3]
+	})
+	s = zhTimeRegexp.ReplaceAllString(s, "$1:$2")
+	s = zhMeridiemRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		m := zhMeridiemRegexp.FindStringSubmatch(v)
+		switch m[1] {
+		case "凌晨", "早上", "上午":
+			return m[2] + " AM"
+		default:
+			return m[2] + " PM"
+		}
+	})
+	return s
+}
+
+// ParseDate parses text in many common layouts, e.g. "10/17/2026", "17.10.2026", "2026年10月17日", "Oct 17, 2026".
+// It returns the date and the matched layout. Opts can be nil.
+func ParseDate(s string, opts *ParseOptions) (*Date, string, error) {
+	if opts == nil {
+		opts = &ParseOptions{}
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	text := normalizeTimeText(s)
+	for _, layout := range layoutsOf(parseLocale(opts)).dates {
+		if t, err := time.ParseInLocation(layout, text, loc); err == nil {
+			return DateWithTime(t), layout, nil
+		}
+	}
+	t, layout, err := ParseTime(s, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	return DateWithTime(t), layout, nil
+}
+
+// ParseTime parses text in many common date time layouts, e.g. "17 Oct 2026 3:04 PM", "2026-10-17T15:04:05Z".
+// It returns the time and the matched layout. Opts can be nil.
+func ParseTime(s string, opts *ParseOptions) (time.Time, string, error) {
+	if opts == nil {
+		opts = &ParseOptions{}
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	text := normalizeTimeText(s)
+	locale := parseLocale(opts)
+	g := timeLayoutGroup(text)
+	for _, layout := range layoutsOf(locale).times[g] {
+		if t, err := time.ParseInLocation(layout, text, loc); err == nil {
+			return t, layout, nil
+		}
+	}
+	return time.Time{}, "", &ParseError{Text: s, Locale: locale, Shape: timeLayoutShape(g)}
+}
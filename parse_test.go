@@ -0,0 +1,82 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	expected := timex.NewDate(2026, 10, 17)
+	for _, s := range []string{
+		"10/17/2026",
+		"17.10.2026",
+		"2026年10月17日",
+		"Oct 17, 2026",
+		"October 17th, 2026",
+		"Sat, 17 Oct 2026",
+		"2026-10-17",
+		"20261017",
+		"17 Oct 2026 3:04 PM",
+	} {
+		d, layout, err := timex.ParseDate(s, nil)
+		require.NoError(t, err, s)
+		assert.NotEmpty(t, layout)
+		assert.True(t, d.Equals(expected), s)
+	}
+
+	d, layout, err := timex.ParseDate("10/11/2026", &timex.ParseOptions{Locale: "en-US"})
+	require.NoError(t, err)
+	assert.Equal(t, "1/2/2006", layout)
+	assert.Equal(t, 10, d.Month())
+	d, layout, err = timex.ParseDate("10/11/2026", &timex.ParseOptions{Locale: "en_GB"})
+	require.NoError(t, err)
+	assert.Equal(t, "2/1/2006", layout)
+	assert.Equal(t, 11, d.Month())
+
+	// default order follows SetLang
+	timex.SetLang("zh-Hans")
+	d, _, err = timex.ParseDate("10/11/2026", nil)
+	timex.SetLang("en")
+	require.NoError(t, err)
+	assert.Equal(t, 11, d.Month())
+
+	_, _, err = timex.ParseDate("tomorrow", nil)
+	require.Error(t, err)
+	assert.IsType(t, &timex.ParseError{}, err)
+	assert.Equal(t, `timex: cannot parse "tomorrow": no layout of shape words for locale en`, err.Error())
+
+	_, _, err = timex.ParseDate("32/13/2026", &timex.ParseOptions{Locale: "zh-Hans"})
+	require.Error(t, err)
+	assert.Equal(t, `timex: cannot parse "32/13/2026": no layout of shape digits for locale zh-Hans`, err.Error())
+	_, _, err = timex.ParseTime("2026-10-17T25:00:00", nil)
+	assert.Equal(t, `timex: cannot parse "2026-10-17T25:00:00": no layout of shape digits with T for locale en`, err.Error())
+}
+
+func TestParseTime(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	opts := &timex.ParseOptions{Location: loc}
+	tests := []struct {
+		Text string
+		Time time.Time
+	}{
+		{"17 Oct 2026 3:04 PM", time.Date(2026, 10, 17, 15, 4, 0, 0, loc)},
+		{"Oct 17, 2026 3:04pm", time.Date(2026, 10, 17, 15, 4, 0, 0, loc)},
+		{"2026-10-17 08:30:15", time.Date(2026, 10, 17, 8, 30, 15, 0, loc)},
+		{"2026-10-17T08:30:15Z", time.Date(2026, 10, 17, 8, 30, 15, 0, time.UTC)},
+		{"2026年10月17日 下午3:04", time.Date(2026, 10, 17, 15, 4, 0, 0, loc)},
+		{"2026年10月17日 15时04分", time.Date(2026, 10, 17, 15, 4, 0, 0, loc)},
+		{"Sat, 17 Oct 2026 15:04:05 +0800", time.Date(2026, 10, 17, 15, 4, 5, 0, loc)},
+	}
+	for _, test := range tests {
+		tm, layout, err := timex.ParseTime(test.Text, opts)
+		require.NoError(t, err, test.Text)
+		assert.NotEmpty(t, layout)
+		assert.True(t, tm.Equal(test.Time), test.Text, tm)
+	}
+	_, _, err := timex.ParseTime("2026-10-17 25:00", opts)
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package timex
+
+import (
+	"fmt"
+)
+
+// persianBreaks are years of Solar Hijri calendar when the 33-year leap cycle restarts, by Kazimierz Borkowski
+var persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+type persianSystem struct{}
+
+// Persian is the Solar Hijri calendar, computed arithmetically for years 1-3177 which agrees with the astronomical calendar.
+// Years -60 to 3177 are supported, i.e. Gregorian dates from March 561 to March 3799. FromDate and ToDate return an error
+// outside the range, IsLeapYear and NumOfMonthDays panic.
+var Persian CalendarSystem = persianSystem{}
+
+const (
+	minPersianYear = -60
+	maxPersianYear = 3177
+)
+
+func (persianSystem) checkYear(year int) error {
+	if year < minPersianYear || year > maxPersianYear {
+		return fmt.Errorf("persian year %d is out of range [%d, %d]", year, minPersianYear, maxPersianYear)
+	}
+	return nil
+}
+
+func (persianSystem) ID() string {
+	return "persian"
+}
+
+// yearInfo returns years since last leap year (0 means leap year), and the day in March of Farvardin 1
+func (persianSystem) yearInfo(year int) (leap, march int) {
+	if year < minPersianYear || year > maxPersianYear {
+		panic(fmt.Sprintf("timex: persian year %d out of range", year))
+	}
+	gy := year + 621
+	leapJ := -14
+	jp := persianBreaks[0]
+	jump := 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, march
+}
+
+func (s persianSystem) IsLeapYear(year int) bool {
+	leap, _ := s.yearInfo(year)
+	return leap == 0
+}
+
+func (persianSystem) NumOfMonths(year int) int {
+	return 12
+}
+
+func (s persianSystem) NumOfMonthDays(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	case s.IsLeapYear(year):
+		return 30
+	default:
+		return 29
+	}
+}
+
+func (persianSystem) MonthName(year, month int) string {
+	return localizedName("persian_months", month-1)
+}
+
+func (s persianSystem) julianDayNumber(year, month, day int) int {
+	_, march := s.yearInfo(year)
+	return julianDayNumber(year+621, 3, march) + (month-1)*31 - month/7*(month-7) + day - 1
+}
+
+func (s persianSystem) ToDate(year, month, day int) (*Date, error) {
+	if err := s.checkYear(year); err != nil {
+		return nil, err
+	}
+	if err := checkCalendarDate(s, year, month, day); err != nil {
+		return nil, err
+	}
+	return dateOfJulianDayNumber(s.julianDayNumber(year, month, day)), nil
+}
+
+func (s persianSystem) FromDate(d *Date) (*CalendarDate, error) {
+	jdn := julianDayNumber(d.year, d.month, d.day)
+	year := d.year - 621
+	switch {
+	case year == maxPersianYear+1:
+		// Farvardin 1 after the range is unknown, compare with the last day of the range
+		if jdn <= s.julianDayNumber(maxPersianYear, 12, s.NumOfMonthDays(maxPersianYear, 12)) {
+			year--
+		}
+	case year >= minPersianYear && jdn < s.julianDayNumber(year, 1, 1):
+		year--
+	}
+	if err := s.checkYear(year); err != nil {
+		return nil, fmt.Errorf("convert %s: %w", d, err)
+	}
+	k := jdn - s.julianDayNumber(year, 1, 1)
+	c := &CalendarDate{System: s, Year: year}
+	if k < 186 {
+		c.Month = 1 + k/31
+		c.Day = k%31 + 1
+	} else {
+		k -= 186
+		c.Month = 7 + k/30
+		c.Day = k%30 + 1
+	}
+	return c, nil
+}
@@ -21,9 +21,8 @@ type Range struct {
 	end   time.Time // exclusive
 }
 
+// NewRange creates [begin, end), both keep their time zones
 func NewRange(begin, end time.Time) *Range {
-	begin = begin.Local()
-	end = end.Local()
 	r := &Range{
 		begin: begin,
 		end:   end,
@@ -35,8 +34,8 @@ func NewRange(begin, end time.Time) *Range {
 }
 
 func (r *Range) Set(begin, end time.Time) {
-	r.begin = begin.Local()
-	r.end = end.Local()
+	r.begin = begin
+	r.end = end
 	if r.begin.After(r.end) {
 		panic("timex: expect begin <= end")
 	}
@@ -137,8 +136,10 @@ func (r *Range) ContainsDate(d *Date) bool {
 	return true
 }
 
+// IsAllDay checks if r is exactly a day in begin's time zone, which may be 23 or 25 hours on DST transition days
 func (r *Range) IsAllDay() bool {
-	return r.InDay() && r.Duration() == time.Hour*24
+	d := DateWithTime(r.begin)
+	return r.begin.Equal(d.Begin()) && r.end.Equal(d.Add(0, 0, 1).Begin())
 }
 
 func (r *Range) InDay() bool {
@@ -204,22 +205,19 @@ func (r *Range) LastDay() *Date {
 	return r.EndT().AddNanos(-1).Date()
 }
 
+// SplitInDay splits r at day boundaries in begin's time zone
 func (r *Range) SplitInDay() []*Range {
-	dates := r.Dates()
-	l := make([]*Range, len(dates))
-	beginTime, endTime := GetDayTime(r.begin), GetDayTime(r.end)
-	for i, d := range dates {
-		begin, end := d.Begin(), d.End().Add(time.Nanosecond)
-		if i == 0 {
-			begin = begin.Add(beginTime)
+	begin := DateWithTime(r.begin)
+	var l []*Range
+	for d := begin; d.Equals(begin) || d.Begin().Before(r.end); d = d.Add(0, 0, 1) {
+		b, e := d.Begin(), d.Add(0, 0, 1).Begin()
+		if b.Before(r.begin) {
+			b = r.begin
 		}
-		if i == len(dates)-1 {
-			if endTime == 0 {
-				endTime = Day
-			}
-			end = d.Begin().Add(endTime)
+		if e.After(r.end) {
+			e = r.end
 		}
-		l[i] = NewRange(begin, end)
+		l = append(l, NewRange(b, e))
 	}
 	return l
 }
@@ -295,8 +293,6 @@ func (r *Range) Scan(src interface{}) error {
 	if r.begin.After(r.end) {
 		return fmt.Errorf("begin %v is after end %v", r.begin, r.end)
 	}
-	r.begin = r.begin.Local()
-	r.end = r.end.Local()
 	return nil
 }
 
@@ -325,6 +321,8 @@ func (r *Range) NextRepeat(repeat Repeat) *Range {
 		return r.AddDate(0, 1, 0)
 	case Yearly:
 		return r.AddDate(1, 0, 0)
+	case LunarYearly:
+		return r.addLunarYears(1)
 	default:
 		return nil
 	}
@@ -340,47 +338,34 @@ func (r *Range) PrevRepeat(repeat Repeat) *Range {
 		return r.AddDate(0, -1, 0)
 	case Yearly:
 		return r.AddDate(-1, 0, 0)
+	case LunarYearly:
+		return r.addLunarYears(-1)
 	default:
 		return nil
 	}
 }
 
-func (r *Range) RelativeText() string {
-	hans := IsSimplifiedChinese()
-	begin, end := r.BeginT(), r.EndT()
-	beginText := begin.Date().ShortText()
-	if !begin.IsBeginOfDay() {
-		beginText += " " + strings.ToLower(begin.TimeText())
-	}
-	endText := end.AddNanos(-1).Date().ShortText()
-	if !end.IsBeginOfDay() {
-		endText += " " + strings.ToLower(end.TimeText())
-	}
-	if r.InDay() {
-		switch {
-		case r.IsAllDay():
-			if hans {
-				return beginText + "全天"
-			}
-			return beginText + " all day"
-		case begin.Equals(end):
-			return beginText
-		case begin.IsBeginOfDay():
-			if hans {
-				return endText + " 结束"
-			}
-			return endText + " ends"
-		case end.IsEndOfDay():
-			if hans {
-				return beginText + " 开始"
-			}
-			return beginText + " begins"
-		default:
-			return beginText + " - " + strings.ToLower(end.TimeText())
-		}
+func (r *Range) addLunarYears(years int) *Range {
+	begin := DateWithTime(r.begin)
+	l := begin.Lunar()
+	if l == nil {
+		return nil
+	}
+	rec := NewLunarRecurrence(l)
+	d := begin
+	for ; years > 0 && d != nil; years-- {
+		d = rec.Next(d)
 	}
-	if begin.Year() == end.Year() || end.Year()-1 == time.Now().Year() {
-		endText = strings.Replace(endText, fmt.Sprintf("%d-", begin.Year()), "", 1)
+	for ; years < 0 && d != nil; years++ {
+		d = rec.Prev(d)
 	}
-	return beginText + " - " + endText
+	if d == nil {
+		return nil
+	}
+	return r.AddDate(0, 0, daysBetween(begin, d))
+}
+
+// RelativeText returns text of r relative to today, e.g. Today all day, Today 9:00am - 10:00am
+func (r *Range) RelativeText() string {
+	return defaultFormatter.RelativeText(r)
 }
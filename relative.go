@@ -0,0 +1,71 @@
+package timex
+
+import (
+	"math"
+	"time"
+)
+
+// RelativeTimeText returns text of t relative to now in current language, e.g. just now, 5 minutes ago, in 2 hours,
+// last Wednesday, next month, 3年前
+func RelativeTimeText(t, now time.Time) string {
+	return defaultFormatter.RelativeTimeText(t, now)
+}
+
+// RelativeTimeText returns text of t relative to now, e.g. just now, 5 minutes ago, in 2 hours, last Wednesday, next month.
+// Units are selected like CLDR: minutes under 45 minutes, hours under 22 hours, then calendar days, weeks, months and years
+// in the formatter's time zone or now's time zone.
+func (f *Formatter) RelativeTimeText(t, now time.Time) string {
+	l := f.locale()
+	if f.Location != nil {
+		now = now.In(f.Location)
+	}
+	t = t.In(now.Location())
+	diff := t.Sub(now)
+	begin, end := DateWithTime(now), DateWithTime(t)
+	days := daysBetween(begin, end)
+	switch abs := time.Duration(math.Abs(float64(diff))); {
+	case abs < 45*time.Second:
+		return l.Word("now")
+	case abs < 45*time.Minute:
+		return relativeUnitText(l, "minute", int(math.Round(diff.Minutes())))
+	case abs < 22*time.Hour || days == 0:
+		return relativeUnitText(l, "hour", int(math.Round(diff.Hours())))
+	}
+
+	switch {
+	case days == -1 || days == 1:
+		return l.RelativeDay(days)
+	case days > -7 && days < 7:
+		weeks := daysBetween(f.beginOfWeek(begin), f.beginOfWeek(end)) / 7
+		return l.Format(t, l.Pattern([]string{"last_weekday", "this_weekday", "next_weekday"}[weeks+1]))
+	}
+
+	// weeks are used until the calendar month differs, e.g. in 4 weeks rather than next month on the 31st of the same month
+	weeks := daysBetween(f.beginOfWeek(begin), f.beginOfWeek(end)) / 7
+	months := (end.year-begin.year)*12 + end.month - begin.month
+	if weeks > -4 && weeks < 4 || months == 0 {
+		return relativeUnitText(l, "week", weeks)
+	}
+	if months > -12 && months < 12 {
+		return relativeUnitText(l, "month", months)
+	}
+	return relativeUnitText(l, "year", end.year-begin.year)
+}
+
+func (f *Formatter) beginOfWeek(d *Date) *Date {
+	return d.Add(0, 0, -(d.weekday-f.FirstWeekday+7)%7)
+}
+
+// relativeUnitText returns text of n units, -1 and 1 are last and next, e.g. last week, next month
+func relativeUnitText(l *Locale, unit string, n int) string {
+	switch {
+	case n == -1 && unit != "minute" && unit != "hour":
+		return l.Word("last_" + unit)
+	case n == 1 && unit != "minute" && unit != "hour":
+		return l.Word("next_" + unit)
+	case n < 0:
+		return l.Plural(unit+"_ago", -n)
+	default:
+		return l.Plural(unit+"_later", n)
+	}
+}
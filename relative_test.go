@@ -0,0 +1,72 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatter_RelativeTimeText(t *testing.T) {
+	// Friday
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		t  time.Time
+		en string
+		zh string
+	}{
+		{now.Add(-30 * time.Second), "just now", "刚刚"},
+		{now.Add(-time.Minute), "1 minute ago", "1分钟前"},
+		{now.Add(-5 * time.Minute), "5 minutes ago", "5分钟前"},
+		{now.Add(44 * time.Minute), "in 44 minutes", "44分钟后"},
+		{now.Add(50 * time.Minute), "in 1 hour", "1小时后"},
+		{now.Add(2 * time.Hour), "in 2 hours", "2小时后"},
+		{now.Add(-21 * time.Hour), "21 hours ago", "21小时前"},
+		{now.AddDate(0, 0, -1), "Yesterday", "昨天"},
+		{now.AddDate(0, 0, 1), "Tomorrow", "明天"},
+		{now.AddDate(0, 0, -2), "Wednesday", "本周三"},
+		{now.AddDate(0, 0, -9), "last week", "上周"},
+		{now.AddDate(0, 0, -6), "last Saturday", "上周六"},
+		{now.AddDate(0, 0, 5), "next Wednesday", "下周三"},
+		{now.AddDate(0, 0, 15), "in 2 weeks", "2周后"},
+		{now.AddDate(0, -1, 0), "last month", "上个月"},
+		{now.AddDate(0, 3, 0), "in 3 months", "3个月后"},
+		{now.AddDate(-1, 0, 0), "last year", "去年"},
+		{now.AddDate(-3, 0, 0), "3 years ago", "3年前"},
+	}
+	en := timex.NewFormatter("en", nil)
+	zh := timex.NewFormatter("zh-Hans", nil)
+	for _, test := range tests {
+		assert.Equal(t, test.en, en.RelativeTimeText(test.t, now), test.t)
+		assert.Equal(t, test.zh, zh.RelativeTimeText(test.t, now), test.t)
+	}
+}
+
+func TestFormatter_RelativeTimeText_Locales(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, "3年前", timex.NewFormatter("ja", nil).RelativeTimeText(now.AddDate(-3, 0, 0), now))
+	assert.Equal(t, "先週の土曜日", timex.NewFormatter("ja", nil).RelativeTimeText(now.AddDate(0, 0, -6), now))
+	assert.Equal(t, "2시간 후", timex.NewFormatter("ko", nil).RelativeTimeText(now.Add(2*time.Hour), now))
+	assert.Equal(t, "5分鐘前", timex.NewFormatter("zh-TW", nil).RelativeTimeText(now.Add(-5*time.Minute), now))
+
+	// Sunday is in the same week as Tuesday if weeks begin on Sunday, and in the last week if weeks begin on Monday
+	sunday, tuesday := now.AddDate(0, 0, 2), now.AddDate(0, 0, 4)
+	f := timex.NewFormatter("en", nil)
+	assert.Equal(t, "Sunday", f.RelativeTimeText(sunday, tuesday))
+	f.FirstWeekday = 1
+	assert.Equal(t, "last Sunday", f.RelativeTimeText(sunday, tuesday))
+
+	// later days of the same month are weeks away rather than next month
+	first := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, "in 4 weeks", f.RelativeTimeText(first.AddDate(0, 0, 25), first))
+	assert.Equal(t, "in 4 weeks", f.RelativeTimeText(first.AddDate(0, 0, 28), first))
+	assert.Equal(t, "in 4 weeks", f.RelativeTimeText(first.AddDate(0, 0, 30), first))
+	assert.Equal(t, "next month", f.RelativeTimeText(first.AddDate(0, 0, 31), first))
+	assert.Equal(t, "4 weeks ago", f.RelativeTimeText(first, first.AddDate(0, 0, 30)))
+
+	// day boundaries are in formatter's time zone
+	tokyo := loadLocation(t, "Asia/Tokyo")
+	late := time.Date(2026, 10, 16, 23, 0, 0, 0, tokyo)
+	assert.Equal(t, "Tomorrow", timex.NewFormatter("en", tokyo).RelativeTimeText(late.Add(23*time.Hour), late))
+}
@@ -0,0 +1,125 @@
+package timex
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SolarTerm is one of 24 solar terms (二十四节气), ordered as they occur in a Gregorian year
+type SolarTerm int
+
+const (
+	MinorCold SolarTerm = iota
+	MajorCold
+	StartOfSpring
+	RainWater
+	AwakeningOfInsects
+	SpringEquinox
+	PureBrightness
+	GrainRain
+	StartOfSummer
+	GrainFull
+	GrainInEar
+	SummerSolstice
+	MinorHeat
+	MajorHeat
+	StartOfAutumn
+	EndOfHeat
+	WhiteDew
+	AutumnEquinox
+	ColdDew
+	FrostsDescent
+	StartOfWinter
+	MinorSnow
+	MajorSnow
+	WinterSolstice
+)
+
+func (s SolarTerm) IsValid() bool {
+	return s >= MinorCold && s <= WinterSolstice
+}
+
+// Longitude returns the sun's apparent longitude in degrees when the term begins
+func (s SolarTerm) Longitude() float64 {
+	return normalizeDegrees(285 + 15*float64(s))
+}
+
+func (s SolarTerm) EnglishName() string {
+	return GetLocale("en").Name("solar_terms", int(s))
+}
+
+func (s SolarTerm) ChineseName() string {
+	return GetLocale("zh-Hans").Name("solar_terms", int(s))
+}
+
+// String returns name in current language
+func (s SolarTerm) String() string {
+	if !s.IsValid() {
+		return fmt.Sprint(int(s))
+	}
+	return CurrentLocale().Name("solar_terms", int(s))
+}
+
+// DateIn returns the date of the term in China Standard Time, which Chinese calendar and holidays follow.
+// It makes SolarTerm a HolidayRule, e.g. PureBrightness for Qingming Festival.
+func (s SolarTerm) DateIn(year int) *Date {
+	t := SolarTermTime(year, s).In(chinaStandardTime)
+	return NewDate(t.Year(), int(t.Month()), t.Day())
+}
+
+var _ HolidayRule = SolarTerm(0)
+
+var chinaStandardTime = time.FixedZone("CST", 8*3600)
+
+var solarTermYears sync.Map
+
+// SolarTerms returns instants of 24 solar terms in year
+func SolarTerms(year int) [24]time.Time {
+	if v, ok := solarTermYears.Load(year); ok {
+		return v.([24]time.Time)
+	}
+	var l [24]time.Time
+	for i := range l {
+		s := SolarTerm(i)
+		// initial estimate: the term is about 15 days apart, beginning on Jan 6
+		jd := julianDay(time.Date(year, 1, 6, 0, 0, 0, 0, time.UTC)) + float64(i)*365.2422/24
+		l[i] = sunLongitudeTime(s.Longitude(), universalToDynamical(jd))
+	}
+	solarTermYears.Store(year, l)
+	return l
+}
+
+// SolarTermTime returns the instant of term s in year
+func SolarTermTime(year int, s SolarTerm) time.Time {
+	if !s.IsValid() {
+		panic(fmt.Sprintf("timex: invalid solar term %d", s))
+	}
+	return SolarTerms(year)[s]
+}
+
+// SolarTermDate returns the local date of term s in year
+func SolarTermDate(year int, s SolarTerm) *Date {
+	return DateWithTime(SolarTermTime(year, s).Local())
+}
+
+// SolarTerm returns the term which begins on d in d's time zone
+func (d *Date) SolarTerm() (SolarTerm, bool) {
+	terms := SolarTerms(d.year)
+	// each month has two terms
+	for i := (d.month - 1) * 2; i < d.month*2; i++ {
+		t := terms[i].In(d.t.Location())
+		if t.Year() == d.year && int(t.Month()) == d.month && t.Day() == d.day {
+			return SolarTerm(i), true
+		}
+	}
+	return 0, false
+}
+
+// SolarTermText returns name of the term which begins on d in current language, or empty string
+func (d *Date) SolarTermText() string {
+	if s, ok := d.SolarTerm(); ok {
+		return s.String()
+	}
+	return ""
+}
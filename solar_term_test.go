@@ -0,0 +1,53 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopub/timex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolarTermTime(t *testing.T) {
+	tests := []struct {
+		Year int
+		Term timex.SolarTerm
+		Time time.Time
+	}{
+		{2000, timex.WinterSolstice, time.Date(2000, 12, 21, 13, 37, 0, 0, time.UTC)},
+		{2023, timex.SummerSolstice, time.Date(2023, 6, 21, 14, 57, 0, 0, time.UTC)},
+		{2024, timex.SpringEquinox, time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)},
+		{2024, timex.WinterSolstice, time.Date(2024, 12, 21, 9, 20, 0, 0, time.UTC)},
+		{2025, timex.StartOfSpring, time.Date(2025, 2, 3, 14, 10, 0, 0, time.UTC)},
+		{2026, timex.SpringEquinox, time.Date(2026, 3, 20, 14, 46, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		tm := timex.SolarTermTime(test.Year, test.Term)
+		diff := tm.Sub(test.Time)
+		assert.True(t, diff < time.Minute && diff > -time.Minute, test.Term.EnglishName(), tm)
+	}
+}
+
+func TestDate_SolarTerm(t *testing.T) {
+	assert.True(t, timex.PureBrightness.DateIn(2026).Equals(timex.NewDate(2026, 4, 5)))
+	assert.True(t, timex.PureBrightness.DateIn(2024).Equals(timex.NewDate(2024, 4, 4)))
+
+	d := timex.DateWithTime(time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC))
+	s, ok := d.SolarTerm()
+	assert.True(t, ok)
+	assert.Equal(t, timex.WinterSolstice, s)
+	assert.Equal(t, "冬至", s.ChineseName())
+	assert.Equal(t, "Winter Solstice", d.SolarTermText())
+
+	_, ok = timex.DateWithTime(time.Date(2024, 12, 22, 0, 0, 0, 0, time.UTC)).SolarTerm()
+	assert.False(t, ok)
+
+	n := 0
+	for d := timex.NewDate(2026, 1, 1); d.Year() == 2026; d = d.Add(0, 0, 1) {
+		if _, ok := d.SolarTerm(); ok {
+			n++
+		}
+	}
+	assert.Equal(t, 24, n)
+	assert.True(t, timex.NewCNHolidayProvider().IsHoliday(timex.NewDate(2026, 4, 5)))
+}
@@ -0,0 +1,169 @@
+package timex
+
+import (
+	"math"
+	"time"
+)
+
+// Solar position by NOAA's formulas derived from Jean Meeus, accurate to about a minute between latitudes ±72°
+
+const (
+	sunriseAltitude              = -0.833
+	civilTwilightAltitude        = -6
+	nauticalTwilightAltitude     = -12
+	astronomicalTwilightAltitude = -18
+)
+
+// Place is a position on earth with its time zone
+type Place struct {
+	// Latitude in degrees, positive in northern hemisphere
+	Latitude float64 `json:"latitude"`
+	// Longitude in degrees, positive east of Greenwich
+	Longitude float64 `json:"longitude"`
+	// Location is time zone of the place, nil means time.Local
+	Location *time.Location `json:"-"`
+}
+
+func NewPlace(latitude, longitude float64, loc *time.Location) *Place {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		panic("timex: invalid coordinates")
+	}
+	return &Place{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Location:  loc,
+	}
+}
+
+func (p *Place) location() *time.Location {
+	if p.Location == nil {
+		return time.Local
+	}
+	return p.Location
+}
+
+// SunTimes are solar events of a date at a place. Events which don't occur on the date are zero times, e.g. sunrise in polar night.
+type SunTimes struct {
+	Date      *Date
+	SolarNoon time.Time
+	Sunrise   time.Time
+	Sunset    time.Time
+
+	CivilDawn        time.Time
+	CivilDusk        time.Time
+	NauticalDawn     time.Time
+	NauticalDusk     time.Time
+	AstronomicalDawn time.Time
+	AstronomicalDusk time.Time
+
+	// IsPolarDay is true if the sun never sets on the date
+	IsPolarDay bool
+	// IsPolarNight is true if the sun never rises on the date
+	IsPolarNight bool
+
+	location *time.Location
+}
+
+// Daylight returns the range from sunrise to sunset, the whole day in polar day, or nil in polar night
+func (s *SunTimes) Daylight() *Range {
+	switch {
+	case s.IsPolarNight:
+		return nil
+	case s.IsPolarDay:
+		return s.Date.InLocation(s.location).Range()
+	default:
+		return NewRange(s.Sunrise, s.Sunset)
+	}
+}
+
+// DaylightDuration returns length of daylight
+func (s *SunTimes) DaylightDuration() time.Duration {
+	if r := s.Daylight(); r != nil {
+		return r.Duration()
+	}
+	return 0
+}
+
+// SunTimes returns solar events of d at place p
+func (d *Date) SunTimes(p *Place) *SunTimes {
+	loc := p.location()
+	s := &SunTimes{
+		Date:     d,
+		location: loc,
+	}
+	midnight := julianDay(time.Date(d.year, time.Month(d.month), d.day, 0, 0, 0, 0, time.UTC))
+	// use the UTC day whose solar noon is nearest to local noon, which differs from d in zones far from their longitude,
+	// e.g. UTC+14 at 157°W
+	localNoon := julianDay(time.Date(d.year, time.Month(d.month), d.day, 12, 0, 0, 0, loc))
+	midnight += math.Round(localNoon - (midnight + 0.5 - p.Longitude/360))
+	// solar noon is 12:00 local mean time corrected by equation of time
+	noon := midnight + 0.5 - p.Longitude/360
+	for i := 0; i < 3; i++ {
+		_, eot := sunDeclination(noon)
+		noon = midnight + (720-4*p.Longitude-eot)/1440
+	}
+	s.SolarNoon = timeOfJulianDay(noon).In(loc)
+
+	var polar int
+	s.Sunrise, s.Sunset, polar = sunEventTimes(midnight, noon, p, sunriseAltitude)
+	s.IsPolarDay = polar < 0
+	s.IsPolarNight = polar > 0
+	s.CivilDawn, s.CivilDusk, _ = sunEventTimes(midnight, noon, p, civilTwilightAltitude)
+	s.NauticalDawn, s.NauticalDusk, _ = sunEventTimes(midnight, noon, p, nauticalTwilightAltitude)
+	s.AstronomicalDawn, s.AstronomicalDusk, _ = sunEventTimes(midnight, noon, p, astronomicalTwilightAltitude)
+	return s
+}
+
+// sunEventTimes returns times before and after solar noon when the sun crosses altitude.
+// Polar is negative if the sun is always above the altitude, or positive if always below.
+func sunEventTimes(midnight, noon float64, p *Place, altitude float64) (rise, set time.Time, polar int) {
+	var jds [2]float64
+	for i, sign := range []float64{-1, 1} {
+		jd := noon
+		for j := 0; j < 4; j++ {
+			var h float64
+			decl, eot := sunDeclination(jd)
+			if h, polar = hourAngle(p.Latitude, decl, altitude); polar != 0 {
+				return time.Time{}, time.Time{}, polar
+			}
+			jd = midnight + (720-4*p.Longitude-eot+sign*4*h)/1440
+		}
+		jds[i] = jd
+	}
+	loc := p.location()
+	return timeOfJulianDay(jds[0]).In(loc), timeOfJulianDay(jds[1]).In(loc), 0
+}
+
+// hourAngle returns hour angle in degrees when the sun is at altitude
+func hourAngle(latitude, declination, altitude float64) (float64, int) {
+	lat := latitude * degToRad
+	cosH := (math.Sin(altitude*degToRad) - math.Sin(lat)*math.Sin(declination)) / (math.Cos(lat) * math.Cos(declination))
+	switch {
+	case cosH > 1:
+		return 0, 1
+	case cosH < -1:
+		return 0, -1
+	default:
+		return math.Acos(cosH) / degToRad, 0
+	}
+}
+
+// sunDeclination returns the sun's declination in radians and equation of time in minutes at Julian Day
+func sunDeclination(jd float64) (declination, equationOfTime float64) {
+	t := (jd - julianDayJ2000) / 36525
+	l0 := normalizeDegrees(280.46646+t*(36000.76983+0.0003032*t)) * degToRad
+	m := (357.52911 + t*(35999.05029-0.0001537*t)) * degToRad
+	e := 0.016708634 - t*(0.000042037+0.0000001267*t)
+	c := math.Sin(m)*(1.914602-t*(0.004817+0.000014*t)) + math.Sin(2*m)*(0.019993-0.000101*t) + math.Sin(3*m)*0.000289
+	omega := (125.04 - 1934.136*t) * degToRad
+	lambda := (l0/degToRad + c - 0.00569 - 0.00478*math.Sin(omega)) * degToRad
+	eps0 := 23 + (26+(21.448-t*(46.815+t*(0.00059-t*0.001813)))/60)/60
+	eps := (eps0 + 0.00256*math.Cos(omega)) * degToRad
+	declination = math.Asin(math.Sin(eps) * math.Sin(lambda))
+
+	y := math.Tan(eps / 2)
+	y *= y
+	eot := y*math.Sin(2*l0) - 2*e*math.Sin(m) + 4*e*y*math.Sin(m)*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) - 1.25*e*e*math.Sin(2*m)
+	return declination, 4 * eot / degToRad
+}
@@ -34,12 +34,24 @@ func IsTomorrow(t time.Time) bool {
 	return DateWithTime(t).IsTomorrow()
 }
 
+// BeginOfDay returns midnight of t's date in t's time zone
 func BeginOfDay(t time.Time) time.Time {
-	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	return DateWithTime(t).Begin()
 }
 
+// EndOfDay returns the last nanosecond of t's date in t's time zone
 func EndOfDay(t time.Time) time.Time {
-	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 999999999, time.Local)
+	return DateWithTime(t).End()
+}
+
+// BeginOfDayIn returns midnight of t's date in time zone loc
+func BeginOfDayIn(t time.Time, loc *time.Location) time.Time {
+	return BeginOfDay(t.In(loc))
+}
+
+// EndOfDayIn returns the last nanosecond of t's date in time zone loc
+func EndOfDayIn(t time.Time, loc *time.Location) time.Time {
+	return EndOfDay(t.In(loc))
 }
 
 const (
@@ -47,13 +59,7 @@ const (
 	Week = 7 * 24 * time.Hour
 )
 
-var enWeekdaySymbols = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
-var hansWeekdaySymbols = [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}
-
+// GetWeekdaySymbol returns abbreviated name of weekday d in current language
 func GetWeekdaySymbol(d int) string {
-	d = d % 7
-	if IsSimplifiedChinese() {
-		return hansWeekdaySymbols[d]
-	}
-	return enWeekdaySymbols[d]
+	return defaultFormatter.WeekdaySymbol(d)
 }